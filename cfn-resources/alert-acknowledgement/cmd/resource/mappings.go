@@ -0,0 +1,94 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const alertStatusOpen = "OPEN"
+
+// NewAcknowledgeAlertReq returns the request that acknowledges an alert until AcknowledgedUntil.
+func NewAcknowledgeAlertReq(model *Model) (*admin.AcknowledgeAlert, error) {
+	until, err := util.StringToTime(util.SafeString(model.AcknowledgedUntil))
+	if err != nil {
+		return nil, fmt.Errorf("the AcknowledgedUntil value must be an ISO 8601 timestamp, e.g. 2025-06-01T08:00:00Z: %w", err)
+	}
+	until = until.UTC()
+	return &admin.AcknowledgeAlert{
+		AcknowledgedUntil:      &until,
+		AcknowledgementComment: model.AcknowledgementComment,
+	}, nil
+}
+
+// NewUnacknowledgeAlertReq returns the request that removes the acknowledgement of an alert.
+func NewUnacknowledgeAlertReq() *admin.AcknowledgeAlert {
+	return &admin.AcknowledgeAlert{UnacknowledgeAlert: util.Pointer(true)}
+}
+
+// AlertIDsToAcknowledge returns the IDs of the open alerts that aren't acknowledged at now, plus the open alerts
+// in ownedIDs that were acknowledged by the resource before. Alerts acknowledged by hand are left alone.
+func AlertIDsToAcknowledge(alerts []admin.AlertViewForNdsGroup, ownedIDs []string, now time.Time) []string {
+	ids := make([]string, 0)
+	for i := range alerts {
+		if alerts[i].GetStatus() != alertStatusOpen {
+			continue
+		}
+		if !isAcknowledged(&alerts[i], now) || slices.Contains(ownedIDs, alerts[i].GetId()) {
+			ids = append(ids, alerts[i].GetId())
+		}
+	}
+	return ids
+}
+
+// OwnedAcknowledgedAlertIDs returns the IDs in ownedIDs whose alerts are still open and acknowledged at now until
+// acknowledgedUntil. Alerts that were acknowledged again by hand until another time aren't returned.
+func OwnedAcknowledgedAlertIDs(alerts []admin.AlertViewForNdsGroup, ownedIDs []string, acknowledgedUntil *string, now time.Time) []string {
+	until, err := util.StringToTime(util.SafeString(acknowledgedUntil))
+	checkUntil := err == nil
+	ids := make([]string, 0)
+	for i := range alerts {
+		if !isAcknowledged(&alerts[i], now) || !slices.Contains(ownedIDs, alerts[i].GetId()) {
+			continue
+		}
+		if checkUntil && !alerts[i].GetAcknowledgedUntil().Truncate(time.Second).Equal(until.Truncate(time.Second)) {
+			continue
+		}
+		ids = append(ids, alerts[i].GetId())
+	}
+	return ids
+}
+
+func isAcknowledged(alert *admin.AlertViewForNdsGroup, now time.Time) bool {
+	until, ok := alert.GetAcknowledgedUntilOk()
+	return alert.GetStatus() == alertStatusOpen && ok && until.After(now)
+}
+
+func GetAlertAcknowledgementModel(acknowledgedAlertIDs []string, currentModel *Model) *Model {
+	return &Model{
+		Profile:                currentModel.Profile,
+		ProjectId:              currentModel.ProjectId,
+		AlertConfigId:          currentModel.AlertConfigId,
+		AcknowledgedUntil:      currentModel.AcknowledgedUntil,
+		AcknowledgementComment: currentModel.AcknowledgementComment,
+		AcknowledgedAlertIds:   acknowledgedAlertIDs,
+	}
+}
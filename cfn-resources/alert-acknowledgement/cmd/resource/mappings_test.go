@@ -0,0 +1,144 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/alert-acknowledgement/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+
+func TestNewAcknowledgeAlertReq(t *testing.T) {
+	tests := []struct {
+		model       *resource.Model
+		expected    *admin.AcknowledgeAlert
+		name        string
+		expectedErr bool
+	}{
+		{
+			name:     "With Comment",
+			model:    &resource.Model{AcknowledgedUntil: ptr.String("2025-06-01T08:00:00Z"), AcknowledgementComment: ptr.String("maintenance")},
+			expected: &admin.AcknowledgeAlert{AcknowledgedUntil: &now, AcknowledgementComment: ptr.String("maintenance")},
+		},
+		{
+			name:     "With Offset",
+			model:    &resource.Model{AcknowledgedUntil: ptr.String("2025-06-01T10:00:00+02:00")},
+			expected: &admin.AcknowledgeAlert{AcknowledgedUntil: &now},
+		},
+		{
+			name:        "Invalid Timestamp",
+			model:       &resource.Model{AcknowledgedUntil: ptr.String("tomorrow")},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := resource.NewAcknowledgeAlertReq(tc.model)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, req)
+		})
+	}
+}
+
+var alerts = []admin.AlertViewForNdsGroup{
+	{Id: ptr.String("open"), Status: ptr.String("OPEN")},
+	{Id: ptr.String("acknowledged"), Status: ptr.String("OPEN"), AcknowledgedUntil: ptr.Time(now.Add(time.Hour))},
+	{Id: ptr.String("byHand"), Status: ptr.String("OPEN"), AcknowledgedUntil: ptr.Time(now.Add(2 * time.Hour))},
+	{Id: ptr.String("expired"), Status: ptr.String("OPEN"), AcknowledgedUntil: ptr.Time(now.Add(-time.Hour))},
+	{Id: ptr.String("closed"), Status: ptr.String("CLOSED"), AcknowledgedUntil: ptr.Time(now.Add(time.Hour))},
+	{Id: ptr.String("tracking"), Status: ptr.String("TRACKING")},
+}
+
+func TestAlertIDsToAcknowledge(t *testing.T) {
+	tests := []struct {
+		alerts   []admin.AlertViewForNdsGroup
+		ownedIDs []string
+		expected []string
+		name     string
+	}{
+		{
+			name:     "No Alerts",
+			expected: []string{},
+		},
+		{
+			name:     "Not Owned",
+			alerts:   alerts,
+			expected: []string{"open", "expired"},
+		},
+		{
+			name:     "Owned",
+			alerts:   alerts,
+			ownedIDs: []string{"acknowledged", "closed"},
+			expected: []string{"open", "acknowledged", "expired"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.AlertIDsToAcknowledge(tc.alerts, tc.ownedIDs, now))
+		})
+	}
+}
+
+func TestOwnedAcknowledgedAlertIDs(t *testing.T) {
+	tests := []struct {
+		acknowledgedUntil *string
+		alerts            []admin.AlertViewForNdsGroup
+		ownedIDs          []string
+		expected          []string
+		name              string
+	}{
+		{
+			name:     "Nothing Owned",
+			alerts:   alerts,
+			expected: []string{},
+		},
+		{
+			name:     "Owned Without Acknowledged Until",
+			alerts:   alerts,
+			ownedIDs: []string{"open", "acknowledged", "byHand", "expired", "closed"},
+			expected: []string{"acknowledged", "byHand"},
+		},
+		{
+			name:              "Acknowledged Again By Hand",
+			alerts:            alerts,
+			ownedIDs:          []string{"open", "acknowledged", "byHand", "expired", "closed"},
+			acknowledgedUntil: ptr.String("2025-06-01T09:00:00Z"),
+			expected:          []string{"acknowledged"},
+		},
+		{
+			name:              "Not Acknowledged Anymore",
+			alerts:            alerts,
+			ownedIDs:          []string{"open", "expired", "closed"},
+			acknowledgedUntil: ptr.String("2025-06-01T09:00:00Z"),
+			expected:          []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.OwnedAcknowledgedAlertIDs(tc.alerts, tc.ownedIDs, tc.acknowledgedUntil, now))
+		})
+	}
+}
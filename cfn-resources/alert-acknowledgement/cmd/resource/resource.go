@@ -0,0 +1,205 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const defaultItemsPerPage = 100
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.AlertConfigID, constants.AcknowledgedUntil}
+var RequiredFields = []string{constants.ProjectID, constants.AlertConfigID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-alert-acknowledgement")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create acknowledges the open alerts of the alert configuration that aren't acknowledged yet, succeeding with an
+// empty AcknowledgedAlertIds when there are none. The IDs of the acknowledged alerts are kept in AcknowledgedAlertIds,
+// so only those are unacknowledged when the resource is deleted. Alerts opened later aren't acknowledged.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	return acknowledgeOpenAlerts(conn, currentModel, nil, constants.CREATE, "Create Completed")
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	alertIDs, pe := getOwnedAcknowledgedAlertIDs(conn, currentModel, constants.READ)
+	if pe != nil {
+		return *pe, nil
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetAlertAcknowledgementModel(alertIDs, currentModel),
+	}, nil
+}
+
+// Update acknowledges the open alerts acknowledged by the resource again, so the new AcknowledgedUntil applies to
+// them, together with the alerts opened since the last acknowledgement.
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	var ownedIDs []string
+	if prevModel != nil {
+		ownedIDs = prevModel.AcknowledgedAlertIds
+	}
+	return acknowledgeOpenAlerts(conn, currentModel, ownedIDs, constants.UPDATE, "Update Completed")
+}
+
+// Delete unacknowledges the alerts acknowledged by the resource whose acknowledgement hasn't expired yet, and does
+// nothing else when there are none left.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	alertIDs, pe := getOwnedAcknowledgedAlertIDs(conn, currentModel, constants.DELETE)
+	if pe != nil {
+		return *pe, nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	for _, alertID := range alertIDs {
+		if _, apiResp, err := conn.AlertsApi.AcknowledgeAlert(ctx, projectID, alertID, NewUnacknowledgeAlertReq()).Execute(); err != nil {
+			return handleError(apiResp, constants.DELETE, err)
+		}
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+// List returns the acknowledgement of the alert configuration, which is the only one that can exist for it.
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	alerts, apiResp, err := getAllAlerts(context.Background(), conn, *currentModel.ProjectId, *currentModel.AlertConfigId)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	alertIDs := OwnedAcknowledgedAlertIDs(alerts, currentModel.AcknowledgedAlertIds, currentModel.AcknowledgedUntil, time.Now())
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  []any{GetAlertAcknowledgementModel(alertIDs, currentModel)},
+	}, nil
+}
+
+func acknowledgeOpenAlerts(conn *admin.APIClient, currentModel *Model, ownedIDs []string, method constants.CfnFunctions, message string) (handler.ProgressEvent, error) {
+	acknowledgeReq, err := NewAcknowledgeAlertReq(currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	alerts, apiResp, err := getAllAlerts(ctx, conn, projectID, *currentModel.AlertConfigId)
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	alertIDs := AlertIDsToAcknowledge(alerts, ownedIDs, time.Now())
+	for _, alertID := range alertIDs {
+		if _, apiResp, err := conn.AlertsApi.AcknowledgeAlert(ctx, projectID, alertID, acknowledgeReq).Execute(); err != nil {
+			return handleError(apiResp, method, err)
+		}
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         message,
+		ResourceModel:   GetAlertAcknowledgementModel(alertIDs, currentModel),
+	}, nil
+}
+
+// getOwnedAcknowledgedAlertIDs returns the alerts acknowledged by the resource that are still acknowledged. The list
+// is empty once they have all been closed or their acknowledgement has expired, which doesn't remove the resource.
+func getOwnedAcknowledgedAlertIDs(conn *admin.APIClient, currentModel *Model, method constants.CfnFunctions) ([]string, *handler.ProgressEvent) {
+	alerts, apiResp, err := getAllAlerts(context.Background(), conn, *currentModel.ProjectId, *currentModel.AlertConfigId)
+	if err != nil {
+		pe, _ := handleError(apiResp, method, err)
+		return nil, &pe
+	}
+
+	return OwnedAcknowledgedAlertIDs(alerts, currentModel.AcknowledgedAlertIds, currentModel.AcknowledgedUntil, time.Now()), nil
+}
+
+func getAllAlerts(ctx context.Context, conn *admin.APIClient, projectID, alertConfigID string) ([]admin.AlertViewForNdsGroup, *http.Response, error) {
+	alerts := make([]admin.AlertViewForNdsGroup, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, apiResp, err := conn.AlertsApi.GetAlertConfigAlerts(ctx, projectID, alertConfigID).
+			PageNum(pageNum).ItemsPerPage(defaultItemsPerPage).Execute()
+		if err != nil {
+			return nil, apiResp, err
+		}
+		results := page.GetResults()
+		alerts = append(alerts, results...)
+		if len(results) == 0 || page.GetTotalCount() <= len(alerts) {
+			return alerts, nil, nil
+		}
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,45 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+set -euo pipefail
+
+function usage {
+	echo "usage:$0"
+	echo "Set the environment variables to use: 'export PROJECT_ID=<project_id>' and 'export ALERT_CONFIG_ID=<alert_config_id>'"
+}
+
+if [[ "$*" == help ]]; then usage; fi
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="default"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+projectId="${PROJECT_ID}"
+alertConfigId="${ALERT_CONFIG_ID}"
+acknowledgedUntil=$(date -u -d "+1 day" +%Y-%m-%dT%H:%M:%SZ)
+updatedAcknowledgedUntil=$(date -u -d "+2 days" +%Y-%m-%dT%H:%M:%SZ)
+
+cd "$(dirname "$0")" || exit
+for inputFile in inputs_*; do
+	outputFile=${inputFile//template./}
+	until="$acknowledgedUntil"
+	if [[ "$inputFile" == *update* ]]; then until="$updatedAcknowledgedUntil"; fi
+	jq --arg ProjectId "$projectId" \
+		--arg AlertConfigId "$alertConfigId" \
+		--arg AcknowledgedUntil "$until" \
+		--arg profile "$profile" \
+		'.Profile?|=$profile | .ProjectId?|=$ProjectId | .AlertConfigId?|=$AlertConfigId | .AcknowledgedUntil?|=$AcknowledgedUntil' \
+		"$inputFile" >"../inputs/$outputFile"
+done
+cd ..
+
+ls -l inputs
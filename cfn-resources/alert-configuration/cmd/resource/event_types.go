@@ -0,0 +1,311 @@
+// Code generated by gen/main.go from the Atlas Admin API specification. DO NOT EDIT.
+
+package resource
+
+// EventTypeNames contains the event types that can trigger a project alert.
+var EventTypeNames = map[string]bool{
+	"AWS_ENCRYPTION_KEY_INVALID":                                       true,
+	"AWS_ENCRYPTION_KEY_NEEDS_ROTATION":                                true,
+	"AZURE_ENCRYPTION_KEY_INVALID":                                     true,
+	"AZURE_ENCRYPTION_KEY_NEEDS_ROTATION":                              true,
+	"CLUSTER_AUTO_SHARDING_INITIATED":                                  true,
+	"CLUSTER_BLOCK_WRITE":                                              true,
+	"CLUSTER_INSTANCE_RESYNC_REQUESTED":                                true,
+	"CLUSTER_INSTANCE_STOP_START":                                      true,
+	"CLUSTER_INSTANCE_UPDATE_REQUESTED":                                true,
+	"CLUSTER_MONGOS_IS_MISSING":                                        true,
+	"CLUSTER_TAGS_MODIFIED":                                            true,
+	"CLUSTER_UNBLOCK_WRITE":                                            true,
+	"COMPUTE_AUTO_SCALE_INITIATED_ANALYTICS":                           true,
+	"COMPUTE_AUTO_SCALE_INITIATED_BASE":                                true,
+	"COMPUTE_AUTO_SCALE_MAX_INSTANCE_SIZE_FAIL_ANALYTICS":              true,
+	"COMPUTE_AUTO_SCALE_MAX_INSTANCE_SIZE_FAIL_BASE":                   true,
+	"COMPUTE_AUTO_SCALE_OPLOG_FAIL_ANALYTICS":                          true,
+	"COMPUTE_AUTO_SCALE_OPLOG_FAIL_BASE":                               true,
+	"COMPUTE_AUTO_SCALE_SCALE_DOWN_FAIL_ANALYTICS":                     true,
+	"COMPUTE_AUTO_SCALE_SCALE_DOWN_FAIL_BASE":                          true,
+	"CPS_AUTO_EXPORT_FAILED":                                           true,
+	"CPS_COLLECTION_RESTORE_CANCELED":                                  true,
+	"CPS_COLLECTION_RESTORE_FAILED":                                    true,
+	"CPS_COLLECTION_RESTORE_PARTIAL_SUCCESS":                           true,
+	"CPS_COLLECTION_RESTORE_SUCCESSFUL":                                true,
+	"CPS_CONCURRENT_SNAPSHOT_FAILED_WILL_RETRY":                        true,
+	"CPS_COPY_SNAPSHOT_FAILED":                                         true,
+	"CPS_COPY_SNAPSHOT_FAILED_WILL_RETRY":                              true,
+	"CPS_COPY_SNAPSHOT_STARTED":                                        true,
+	"CPS_COPY_SNAPSHOT_SUCCESSFUL":                                     true,
+	"CPS_DATA_PROTECTION_APPROVED_FOR_DISABLEMENT":                     true,
+	"CPS_DATA_PROTECTION_DISABLED":                                     true,
+	"CPS_DATA_PROTECTION_DISABLE_REQUESTED":                            true,
+	"CPS_DATA_PROTECTION_ENABLED":                                      true,
+	"CPS_DATA_PROTECTION_ENABLE_REQUESTED":                             true,
+	"CPS_DATA_PROTECTION_UPDATED":                                      true,
+	"CPS_DATA_PROTECTION_UPDATE_REQUESTED":                             true,
+	"CPS_EXPORT_FAILED":                                                true,
+	"CPS_EXPORT_SUCCESSFUL":                                            true,
+	"CPS_OPLOG_BEHIND":                                                 true,
+	"CPS_OPLOG_CAUGHT_UP":                                              true,
+	"CPS_PREV_SNAPSHOT_OLD":                                            true,
+	"CPS_RESTORE_FAILED":                                               true,
+	"CPS_RESTORE_SUCCESSFUL":                                           true,
+	"CPS_SNAPSHOT_BEHIND":                                              true,
+	"CPS_SNAPSHOT_DOWNLOAD_REQUEST_FAILED":                             true,
+	"CPS_SNAPSHOT_FAILED":                                              true,
+	"CPS_SNAPSHOT_FALLBACK_FAILED":                                     true,
+	"CPS_SNAPSHOT_FALLBACK_SUCCESSFUL":                                 true,
+	"CPS_SNAPSHOT_STARTED":                                             true,
+	"CPS_SNAPSHOT_SUCCESSFUL":                                          true,
+	"CREDIT_CARD_ABOUT_TO_EXPIRE":                                      true,
+	"DAILY_BILL_OVER_THRESHOLD":                                        true,
+	"DEPLOYMENT_FAILURE":                                               true,
+	"DEPLOYMENT_MODEL_CHANGE_FAILURE":                                  true,
+	"DEPLOYMENT_MODEL_CHANGE_SUCCESS":                                  true,
+	"DISK_AUTO_SCALE_INITIATED":                                        true,
+	"DISK_AUTO_SCALE_MAX_DISK_SIZE_FAIL":                               true,
+	"DISK_AUTO_SCALE_OPLOG_FAIL":                                       true,
+	"ENCRYPTION_AT_REST_CONFIG_NO_LONGER_VALID":                        true,
+	"ENCRYPTION_AT_REST_KMS_NETWORK_ACCESS_DENIED":                     true,
+	"FTS_INDEXES_RESTORE_FAILED":                                       true,
+	"FTS_INDEXES_SYNONYM_MAPPING_INVALID":                              true,
+	"FTS_INDEX_BUILD_COMPLETE":                                         true,
+	"FTS_INDEX_BUILD_FAILED":                                           true,
+	"FTS_INDEX_DELETION_FAILED":                                        true,
+	"FTS_INDEX_STALE":                                                  true,
+	"GCP_ENCRYPTION_KEY_INVALID":                                       true,
+	"GCP_ENCRYPTION_KEY_NEEDS_ROTATION":                                true,
+	"GROUP_SERVICE_ACCOUNT_SECRETS_EXPIRED":                            true,
+	"GROUP_SERVICE_ACCOUNT_SECRETS_EXPIRING":                           true,
+	"GROUP_TAGS_MODIFIED":                                              true,
+	"HOST_DOWN":                                                        true,
+	"HOST_EXPOSED":                                                     true,
+	"HOST_EXTERNAL_LOG_SINK_EXPORT_DOWN":                               true,
+	"HOST_HAS_INDEX_SUGGESTIONS":                                       true,
+	"HOST_MONGOT_APPROACHING_STOP_REPLICATION":                         true,
+	"HOST_MONGOT_CRASHING_OOM":                                         true,
+	"HOST_MONGOT_PAUSE_INITIAL_SYNC":                                   true,
+	"HOST_MONGOT_STOP_REPLICATION":                                     true,
+	"HOST_NOT_ENOUGH_DISK_SPACE":                                       true,
+	"HOST_SEARCH_NODE_INDEX_FAILED":                                    true,
+	"HOST_SECURITY_CHECKUP_NOT_MET":                                    true,
+	"HOST_SSL_CERTIFICATE_STALE":                                       true,
+	"HOST_VERSION_BEHIND":                                              true,
+	"JOINED_GROUP":                                                     true,
+	"LOG_FORWARDER_FAILURE":                                            true,
+	"MAINTENANCE_AUTO_DEFERRED":                                        true,
+	"MAINTENANCE_COMPLETED":                                            true,
+	"MAINTENANCE_IN_ADVANCED":                                          true,
+	"MAINTENANCE_NO_LONGER_NEEDED":                                     true,
+	"MAINTENANCE_STARTED":                                              true,
+	"NDS_X509_USER_AUTHENTICATION_CUSTOMER_CA_EXPIRATION_CHECK":        true,
+	"NDS_X509_USER_AUTHENTICATION_CUSTOMER_CRL_EXPIRATION_CHECK":       true,
+	"NDS_X509_USER_AUTHENTICATION_MANAGED_USER_CERTS_EXPIRATION_CHECK": true,
+	"NETWORK_PERMISSION_ENTRY_ADDED":                                   true,
+	"NETWORK_PERMISSION_ENTRY_REMOVED":                                 true,
+	"NETWORK_PERMISSION_ENTRY_UPDATED":                                 true,
+	"NO_PRIMARY":                                                       true,
+	"ONLINE_ARCHIVE_INSUFFICIENT_INDEXES_CHECK":                        true,
+	"ONLINE_ARCHIVE_MAX_CONSECUTIVE_OFFLOAD_WINDOWS_CHECK":             true,
+	"OUTSIDE_FLEX_METRIC_THRESHOLD":                                    true,
+	"OUTSIDE_METRIC_THRESHOLD":                                         true,
+	"OUTSIDE_REALM_METRIC_THRESHOLD":                                   true,
+	"OUTSIDE_SERVERLESS_METRIC_THRESHOLD":                              true,
+	"OUTSIDE_STREAM_PROCESSOR_METRIC_THRESHOLD":                        true,
+	"PENDING_INVOICE_OVER_THRESHOLD":                                   true,
+	"PREDICTIVE_COMPUTE_AUTO_SCALE_INITIATED_BASE":                     true,
+	"PREDICTIVE_COMPUTE_AUTO_SCALE_MAX_INSTANCE_SIZE_FAIL_BASE":        true,
+	"PREDICTIVE_COMPUTE_AUTO_SCALE_OPLOG_FAIL_BASE":                    true,
+	"PRIMARY_ELECTED":                                                  true,
+	"PUSH_BASED_LOG_EXPORT_DROPPED_LOG":                                true,
+	"PUSH_BASED_LOG_EXPORT_STOPPED":                                    true,
+	"REMOVED_FROM_GROUP":                                               true,
+	"REPLICATION_OPLOG_WINDOW_RUNNING_OUT":                             true,
+	"REQUEST_RATE_LIMIT":                                               true,
+	"RESOURCE_POLICY_VIOLATED":                                         true,
+	"SAMPLE_DATASET_LOAD_REQUESTED":                                    true,
+	"SSH_KEY_NDS_HOST_ACCESS_REFRESHED":                                true,
+	"SSH_KEY_NDS_HOST_ACCESS_REQUESTED":                                true,
+	"STREAM_PROCESSOR_STATE_IS_FAILED":                                 true,
+	"SUCCESSFUL_DEPLOY":                                                true,
+	"SYNC_FAILURE":                                                     true,
+	"TAGS_MODIFIED":                                                    true,
+	"TENANT_UPGRADE_TO_SERVERLESS_FAILED":                              true,
+	"TENANT_UPGRADE_TO_SERVERLESS_SUCCESSFUL":                          true,
+	"TOO_FEW_HEALTHY_MEMBERS":                                          true,
+	"TOO_MANY_ELECTIONS":                                               true,
+	"TOO_MANY_UNHEALTHY_MEMBERS":                                       true,
+	"TRIGGER_AUTO_RESUMED":                                             true,
+	"TRIGGER_FAILURE":                                                  true,
+	"URL_CONFIRMATION":                                                 true,
+	"USERS_WITHOUT_MULTI_FACTOR_AUTH":                                  true,
+	"USER_ROLES_CHANGED_AUDIT":                                         true,
+	"VERSION_BEHIND":                                                   true,
+}
+
+// HostMetricUnits contains the units that the threshold of each host metric accepts.
+var HostMetricUnits = map[string][]string{
+	"ASSERT_MSG":                                   rawMetricUnits,
+	"ASSERT_REGULAR":                               rawMetricUnits,
+	"ASSERT_USER":                                  rawMetricUnits,
+	"ASSERT_WARNING":                               rawMetricUnits,
+	"AVG_COMMAND_EXECUTION_TIME":                   timeMetricUnits,
+	"AVG_READ_EXECUTION_TIME":                      timeMetricUnits,
+	"AVG_WRITE_EXECUTION_TIME":                     timeMetricUnits,
+	"BACKGROUND_FLUSH_AVG":                         timeMetricUnits,
+	"CACHE_BYTES_READ_INTO":                        dataMetricUnits,
+	"CACHE_BYTES_WRITTEN_FROM":                     dataMetricUnits,
+	"CACHE_USAGE_DIRTY":                            dataMetricUnits,
+	"CACHE_USAGE_USED":                             dataMetricUnits,
+	"COMPUTED_MEMORY":                              dataMetricUnits,
+	"CONNECTIONS":                                  rawMetricUnits,
+	"CONNECTIONS_MAX":                              rawMetricUnits,
+	"CONNECTIONS_PERCENT":                          rawMetricUnits,
+	"CURSORS_TOTAL_CLIENT_CURSORS_SIZE":            rawMetricUnits,
+	"CURSORS_TOTAL_OPEN":                           rawMetricUnits,
+	"CURSORS_TOTAL_TIMED_OUT":                      rawMetricUnits,
+	"DB_DATA_SIZE_TOTAL":                           dataMetricUnits,
+	"DB_DATA_SIZE_TOTAL_WO_SYSTEM":                 dataMetricUnits,
+	"DB_INDEX_SIZE_TOTAL":                          dataMetricUnits,
+	"DB_STORAGE_TOTAL":                             dataMetricUnits,
+	"DISK_PARTITION_QUEUE_DEPTH_DATA":              rawMetricUnits,
+	"DISK_PARTITION_QUEUE_DEPTH_INDEX":             rawMetricUnits,
+	"DISK_PARTITION_QUEUE_DEPTH_JOURNAL":           rawMetricUnits,
+	"DISK_PARTITION_READ_IOPS_DATA":                rawMetricUnits,
+	"DISK_PARTITION_READ_IOPS_INDEX":               rawMetricUnits,
+	"DISK_PARTITION_READ_IOPS_JOURNAL":             rawMetricUnits,
+	"DISK_PARTITION_READ_LATENCY_DATA":             timeMetricUnits,
+	"DISK_PARTITION_READ_LATENCY_INDEX":            timeMetricUnits,
+	"DISK_PARTITION_READ_LATENCY_JOURNAL":          timeMetricUnits,
+	"DISK_PARTITION_SPACE_USED_DATA":               rawMetricUnits,
+	"DISK_PARTITION_SPACE_USED_INDEX":              rawMetricUnits,
+	"DISK_PARTITION_SPACE_USED_JOURNAL":            rawMetricUnits,
+	"DISK_PARTITION_WRITE_IOPS_DATA":               rawMetricUnits,
+	"DISK_PARTITION_WRITE_IOPS_INDEX":              rawMetricUnits,
+	"DISK_PARTITION_WRITE_IOPS_JOURNAL":            rawMetricUnits,
+	"DISK_PARTITION_WRITE_LATENCY_DATA":            timeMetricUnits,
+	"DISK_PARTITION_WRITE_LATENCY_INDEX":           timeMetricUnits,
+	"DISK_PARTITION_WRITE_LATENCY_JOURNAL":         timeMetricUnits,
+	"DOCUMENT_DELETED":                             rawMetricUnits,
+	"DOCUMENT_INSERTED":                            rawMetricUnits,
+	"DOCUMENT_RETURNED":                            rawMetricUnits,
+	"DOCUMENT_UPDATED":                             rawMetricUnits,
+	"EXTRA_INFO_PAGE_FAULTS":                       rawMetricUnits,
+	"FTS_DISK_UTILIZATION":                         dataMetricUnits,
+	"FTS_JVM_CURRENT_MEMORY":                       dataMetricUnits,
+	"FTS_JVM_MAX_MEMORY":                           dataMetricUnits,
+	"FTS_MEMORY_MAPPED":                            dataMetricUnits,
+	"FTS_MEMORY_RESIDENT":                          dataMetricUnits,
+	"FTS_MEMORY_VIRTUAL":                           dataMetricUnits,
+	"FTS_PROCESS_CPU_KERNEL":                       rawMetricUnits,
+	"FTS_PROCESS_CPU_USER":                         rawMetricUnits,
+	"GLOBAL_ACCESSES_NOT_IN_MEMORY":                rawMetricUnits,
+	"GLOBAL_LOCK_CURRENT_QUEUE_READERS":            rawMetricUnits,
+	"GLOBAL_LOCK_CURRENT_QUEUE_TOTAL":              rawMetricUnits,
+	"GLOBAL_LOCK_CURRENT_QUEUE_WRITERS":            rawMetricUnits,
+	"GLOBAL_LOCK_PERCENTAGE":                       rawMetricUnits,
+	"GLOBAL_PAGE_FAULT_EXCEPTIONS_THROWN":          rawMetricUnits,
+	"INDEX_COUNTERS_BTREE_ACCESSES":                rawMetricUnits,
+	"INDEX_COUNTERS_BTREE_HITS":                    rawMetricUnits,
+	"INDEX_COUNTERS_BTREE_MISSES":                  rawMetricUnits,
+	"INDEX_COUNTERS_BTREE_MISS_RATIO":              rawMetricUnits,
+	"JOURNALING_COMMITS_IN_WRITE_LOCK":             rawMetricUnits,
+	"JOURNALING_MB":                                dataMetricUnits,
+	"JOURNALING_WRITE_DATA_FILES_MB":               dataMetricUnits,
+	"LOGICAL_SIZE":                                 dataMetricUnits,
+	"MAX_DISK_PARTITION_QUEUE_DEPTH_DATA":          rawMetricUnits,
+	"MAX_DISK_PARTITION_QUEUE_DEPTH_INDEX":         rawMetricUnits,
+	"MAX_DISK_PARTITION_QUEUE_DEPTH_JOURNAL":       rawMetricUnits,
+	"MAX_DISK_PARTITION_READ_IOPS_DATA":            rawMetricUnits,
+	"MAX_DISK_PARTITION_READ_IOPS_INDEX":           rawMetricUnits,
+	"MAX_DISK_PARTITION_READ_IOPS_JOURNAL":         rawMetricUnits,
+	"MAX_DISK_PARTITION_READ_LATENCY_DATA":         timeMetricUnits,
+	"MAX_DISK_PARTITION_READ_LATENCY_INDEX":        timeMetricUnits,
+	"MAX_DISK_PARTITION_READ_LATENCY_JOURNAL":      timeMetricUnits,
+	"MAX_DISK_PARTITION_SPACE_USED_DATA":           rawMetricUnits,
+	"MAX_DISK_PARTITION_SPACE_USED_INDEX":          rawMetricUnits,
+	"MAX_DISK_PARTITION_SPACE_USED_JOURNAL":        rawMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_IOPS_DATA":           rawMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_IOPS_INDEX":          rawMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_IOPS_JOURNAL":        rawMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_LATENCY_DATA":        timeMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_LATENCY_INDEX":       timeMetricUnits,
+	"MAX_DISK_PARTITION_WRITE_LATENCY_JOURNAL":     timeMetricUnits,
+	"MAX_NORMALIZED_SYSTEM_CPU_STEAL":              rawMetricUnits,
+	"MAX_NORMALIZED_SYSTEM_CPU_USER":               rawMetricUnits,
+	"MAX_SWAP_USAGE_FREE":                          dataMetricUnits,
+	"MAX_SWAP_USAGE_USED":                          dataMetricUnits,
+	"MAX_SYSTEM_MEMORY_AVAILABLE":                  dataMetricUnits,
+	"MAX_SYSTEM_MEMORY_PERCENT_USED":               rawMetricUnits,
+	"MAX_SYSTEM_MEMORY_USED":                       dataMetricUnits,
+	"MAX_SYSTEM_NETWORK_IN":                        dataMetricUnits,
+	"MAX_SYSTEM_NETWORK_OUT":                       dataMetricUnits,
+	"MEMORY_MAPPED":                                dataMetricUnits,
+	"MEMORY_RESIDENT":                              dataMetricUnits,
+	"MEMORY_VIRTUAL":                               dataMetricUnits,
+	"MUNIN_CPU_IOWAIT":                             rawMetricUnits,
+	"MUNIN_CPU_IRQ":                                rawMetricUnits,
+	"MUNIN_CPU_NICE":                               rawMetricUnits,
+	"MUNIN_CPU_SOFTIRQ":                            rawMetricUnits,
+	"MUNIN_CPU_STEAL":                              rawMetricUnits,
+	"MUNIN_CPU_SYSTEM":                             rawMetricUnits,
+	"MUNIN_CPU_USER":                               rawMetricUnits,
+	"NETWORK_BYTES_IN":                             dataMetricUnits,
+	"NETWORK_BYTES_OUT":                            dataMetricUnits,
+	"NETWORK_NUM_REQUESTS":                         rawMetricUnits,
+	"NORMALIZED_FTS_PROCESS_CPU_KERNEL":            rawMetricUnits,
+	"NORMALIZED_FTS_PROCESS_CPU_USER":              rawMetricUnits,
+	"NORMALIZED_SYSTEM_CPU_STEAL":                  rawMetricUnits,
+	"NORMALIZED_SYSTEM_CPU_USER":                   rawMetricUnits,
+	"OPCOUNTER_CMD":                                rawMetricUnits,
+	"OPCOUNTER_DELETE":                             rawMetricUnits,
+	"OPCOUNTER_GETMORE":                            rawMetricUnits,
+	"OPCOUNTER_INSERT":                             rawMetricUnits,
+	"OPCOUNTER_QUERY":                              rawMetricUnits,
+	"OPCOUNTER_REPL_CMD":                           rawMetricUnits,
+	"OPCOUNTER_REPL_DELETE":                        rawMetricUnits,
+	"OPCOUNTER_REPL_INSERT":                        rawMetricUnits,
+	"OPCOUNTER_REPL_UPDATE":                        rawMetricUnits,
+	"OPCOUNTER_TTL_DELETED":                        rawMetricUnits,
+	"OPCOUNTER_UPDATE":                             rawMetricUnits,
+	"OPERATIONS_QUERIES_KILLED":                    rawMetricUnits,
+	"OPERATIONS_SCAN_AND_ORDER":                    rawMetricUnits,
+	"OPERATION_THROTTLING_REJECTED_OPERATIONS":     rawMetricUnits,
+	"OPLOG_MASTER_LAG_TIME_DIFF":                   timeMetricUnits,
+	"OPLOG_MASTER_TIME":                            timeMetricUnits,
+	"OPLOG_MASTER_TIME_ESTIMATED_TTL":              timeMetricUnits,
+	"OPLOG_RATE_GB_PER_HOUR":                       dataMetricUnits,
+	"OPLOG_SLAVE_LAG_MASTER_TIME":                  timeMetricUnits,
+	"QUERY_EXECUTOR_SCANNED":                       rawMetricUnits,
+	"QUERY_EXECUTOR_SCANNED_OBJECTS":               rawMetricUnits,
+	"QUERY_SPILL_TO_DISK_DURING_SORT":              rawMetricUnits,
+	"QUERY_TARGETING_SCANNED_OBJECTS_PER_RETURNED": rawMetricUnits,
+	"QUERY_TARGETING_SCANNED_PER_RETURNED":         rawMetricUnits,
+	"RESTARTS_IN_LAST_HOUR":                        rawMetricUnits,
+	"SEARCH_INDEX_SIZE":                            dataMetricUnits,
+	"SEARCH_MAX_NUMBER_OF_LUCENE_DOCS":             numberMetricUnits,
+	"SEARCH_NUMBER_OF_FIELDS_IN_INDEX":             rawMetricUnits,
+	"SEARCH_NUMBER_OF_QUERIES_ERROR":               rawMetricUnits,
+	"SEARCH_NUMBER_OF_QUERIES_SUCCESS":             rawMetricUnits,
+	"SEARCH_NUMBER_OF_QUERIES_TOTAL":               rawMetricUnits,
+	"SEARCH_OPCOUNTER_DELETE":                      rawMetricUnits,
+	"SEARCH_OPCOUNTER_GETMORE":                     rawMetricUnits,
+	"SEARCH_OPCOUNTER_INSERT":                      rawMetricUnits,
+	"SEARCH_OPCOUNTER_UPDATE":                      rawMetricUnits,
+	"SEARCH_REPLICATION_LAG":                       timeMetricUnits,
+	"SWAP_USAGE_FREE":                              dataMetricUnits,
+	"SWAP_USAGE_USED":                              dataMetricUnits,
+	"SYSTEM_MEMORY_AVAILABLE":                      dataMetricUnits,
+	"SYSTEM_MEMORY_PERCENT_USED":                   rawMetricUnits,
+	"SYSTEM_MEMORY_USED":                           dataMetricUnits,
+	"SYSTEM_NETWORK_IN":                            dataMetricUnits,
+	"SYSTEM_NETWORK_OUT":                           dataMetricUnits,
+	"TICKETS_AVAILABLE_READS":                      rawMetricUnits,
+	"TICKETS_AVAILABLE_WRITES":                     rawMetricUnits,
+}
+
+var rawMetricUnits = []string{"RAW"}
+
+var dataMetricUnits = []string{"BITS", "KILOBITS", "MEGABITS", "GIGABITS", "BYTES", "KILOBYTES", "MEGABYTES", "GIGABYTES", "TERABYTES", "PETABYTES"}
+
+var timeMetricUnits = []string{"NANOSECONDS", "MILLISECONDS", "MILLION_MINUTES", "SECONDS", "MINUTES", "HOURS", "DAYS"}
+
+var numberMetricUnits = []string{"COUNT", "THOUSAND", "MILLION", "BILLION"}
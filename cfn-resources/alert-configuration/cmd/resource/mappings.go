@@ -0,0 +1,295 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate go run ../../gen/main.go
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+const (
+	outsideMetricThreshold = "OUTSIDE_METRIC_THRESHOLD"
+	minIntervalMin         = 5
+)
+
+// integrationTypeNames are the notification types that can send notifications through a third-party integration.
+var integrationTypeNames = []string{
+	"DATADOG", constants.PagerDuty, constants.OpsGenie, constants.VictorOps, "SLACK", "WEBHOOK", "MICROSOFT_TEAMS",
+}
+
+// ValidateAlertConfig checks the combinations of properties that the schema can't express.
+func ValidateAlertConfig(model *Model) error {
+	if model.EventTypeName != nil && !EventTypeNames[*model.EventTypeName] {
+		return fmt.Errorf("the event type %s is not supported, see the EventTypeName allowed values", *model.EventTypeName)
+	}
+	if err := validateMetricThreshold(model); err != nil {
+		return err
+	}
+	for i := range model.Notifications {
+		if err := validateNotification(&model.Notifications[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateMetricThreshold checks that the units of a host metric threshold match the metric.
+func validateMetricThreshold(model *Model) error {
+	threshold := model.MetricThreshold
+	if threshold == nil || util.SafeString(model.EventTypeName) != outsideMetricThreshold {
+		return nil
+	}
+	metricName := util.SafeString(threshold.MetricName)
+	units, ok := HostMetricUnits[metricName]
+	if !ok {
+		return fmt.Errorf("the metric %s is not a host metric", metricName)
+	}
+	if threshold.Units != nil && !slices.Contains(units, *threshold.Units) {
+		return fmt.Errorf("the units %s are not valid for the metric %s, use one of: %s", *threshold.Units, metricName, strings.Join(units, ", "))
+	}
+	return nil
+}
+
+func validateNotification(notification *NotificationView) error {
+	typeName := util.SafeString(notification.TypeName)
+	if notification.IntervalMin != nil {
+		if slices.Contains([]string{constants.PagerDuty, constants.OpsGenie, constants.VictorOps}, typeName) {
+			return errors.New("the notification interval doesn't need to be set if the notification type is 'PAGER_DUTY', 'OPS_GENIE' or 'VICTOR_OPS'")
+		}
+		if *notification.IntervalMin < minIntervalMin {
+			return fmt.Errorf("the notification interval must be at least %d minutes", minIntervalMin)
+		}
+	}
+	if notification.IntegrationId == nil {
+		return nil
+	}
+	if !slices.Contains(integrationTypeNames, typeName) {
+		return fmt.Errorf("the integration %s can't be used with notifications of type %s", *notification.IntegrationId, typeName)
+	}
+	credentials := []*string{
+		notification.ApiToken, notification.DatadogApiKey, notification.MicrosoftTeamsWebhookUrl, notification.OpsGenieApiKey,
+		notification.ServiceKey, notification.VictorOpsApiKey, notification.VictorOpsRoutingKey, notification.WebhookSecret,
+		notification.WebhookUrl,
+	}
+	for _, credential := range credentials {
+		if credential != nil {
+			return fmt.Errorf("the credentials of the notification can't be set together with the integration %s", *notification.IntegrationId)
+		}
+	}
+	return nil
+}
+
+// NewAlertConfigReq builds the Atlas alert configuration from the model.
+func NewAlertConfigReq(model *Model) *admin.GroupAlertsConfig {
+	return &admin.GroupAlertsConfig{
+		GroupId:         model.ProjectId,
+		EventTypeName:   model.EventTypeName,
+		Enabled:         model.Enabled,
+		Matchers:        expandAlertConfigurationMatchers(model.Matchers),
+		MetricThreshold: expandAlertConfigurationMetricThresholdConfig(model.MetricThreshold),
+		Threshold:       expandAlertConfigurationThreshold(model.Threshold),
+		Notifications:   expandAlertConfigurationNotification(model.Notifications),
+	}
+}
+
+func expandAlertConfigurationMatchers(matchers []Matcher) *[]admin.StreamsMatcher {
+	mts := make([]admin.StreamsMatcher, 0, len(matchers))
+	for i := range matchers {
+		mts = append(mts, *admin.NewStreamsMatcher(
+			util.SafeString(matchers[i].FieldName),
+			util.SafeString(matchers[i].Operator),
+			util.SafeString(matchers[i].Value),
+		))
+	}
+	return &mts
+}
+
+func expandAlertConfigurationMetricThresholdConfig(threshold *MetricThresholdView) *admin.FlexClusterMetricThreshold {
+	if threshold == nil {
+		return nil
+	}
+	return &admin.FlexClusterMetricThreshold{
+		MetricName: util.SafeString(threshold.MetricName),
+		Operator:   threshold.Operator,
+		Threshold:  threshold.Threshold,
+		Units:      threshold.Units,
+		Mode:       threshold.Mode,
+	}
+}
+
+func expandAlertConfigurationThreshold(threshold *IntegerThresholdView) *admin.StreamProcessorMetricThreshold {
+	if threshold == nil {
+		return nil
+	}
+	return &admin.StreamProcessorMetricThreshold{
+		Operator:  threshold.Operator,
+		Threshold: threshold.Threshold,
+		Units:     threshold.Units,
+	}
+}
+
+func expandAlertConfigurationNotification(notificationList []NotificationView) *[]admin.AlertsNotificationRootForGroup {
+	notifications := make([]admin.AlertsNotificationRootForGroup, 0, len(notificationList))
+	for i := range notificationList {
+		n := &notificationList[i]
+		notification := admin.AlertsNotificationRootForGroup{
+			ApiToken:                 n.ApiToken,
+			ChannelName:              n.ChannelName,
+			DatadogApiKey:            n.DatadogApiKey,
+			DatadogRegion:            n.DatadogRegion,
+			DelayMin:                 n.DelayMin,
+			EmailAddress:             n.EmailAddress,
+			EmailEnabled:             n.EmailEnabled,
+			IntegrationId:            n.IntegrationId,
+			MicrosoftTeamsWebhookUrl: n.MicrosoftTeamsWebhookUrl,
+			MobileNumber:             n.MobileNumber,
+			NotificationToken:        n.NotificationToken,
+			NotifierId:               n.NotifierId,
+			OpsGenieApiKey:           n.OpsGenieApiKey,
+			OpsGenieRegion:           n.OpsGenieRegion,
+			RoomName:                 n.RoomName,
+			ServiceKey:               n.ServiceKey,
+			SmsEnabled:               n.SmsEnabled,
+			TeamId:                   n.TeamId,
+			TeamName:                 n.TeamName,
+			TypeName:                 n.TypeName,
+			Username:                 n.Username,
+			VictorOpsApiKey:          n.VictorOpsApiKey,
+			VictorOpsRoutingKey:      n.VictorOpsRoutingKey,
+			WebhookSecret:            n.WebhookSecret,
+			WebhookUrl:               n.WebhookUrl,
+		}
+		if n.IntervalMin != nil {
+			notification.IntervalMin = util.Pointer(int(*n.IntervalMin))
+		}
+		if n.Roles != nil {
+			notification.Roles = &n.Roles
+		}
+		notifications = append(notifications, notification)
+	}
+	return &notifications
+}
+
+// GetAlertConfigModel reads the alert configuration returned by Atlas back into the model. Atlas redacts the
+// secrets of the notifications, so those are kept from the model of the notification at the same position.
+func GetAlertConfigModel(alertConfig *admin.GroupAlertsConfig, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model = currentModel
+	}
+	if alertConfig == nil {
+		return model
+	}
+	model.Id = alertConfig.Id
+	model.Created = util.TimePtrToStringPtr(alertConfig.Created)
+	model.Updated = util.TimePtrToStringPtr(alertConfig.Updated)
+	if alertConfig.Enabled != nil {
+		model.Enabled = alertConfig.Enabled
+	}
+	if alertConfig.EventTypeName != nil {
+		model.EventTypeName = alertConfig.EventTypeName
+	}
+	model.Matchers = nil
+	if matchers := alertConfig.GetMatchers(); len(matchers) > 0 {
+		model.Matchers = flattenAlertConfigurationMatchers(matchers)
+	}
+	model.MetricThreshold = flattenAlertConfigurationMetricThresholdConfig(alertConfig.MetricThreshold)
+	model.Threshold = flattenAlertConfigurationThreshold(alertConfig.Threshold)
+	if alertConfig.Notifications != nil {
+		model.Notifications = flattenAlertConfigurationNotification(alertConfig.GetNotifications(), model.Notifications)
+	}
+	return model
+}
+
+func flattenAlertConfigurationMatchers(matchers []admin.StreamsMatcher) []Matcher {
+	mts := make([]Matcher, 0, len(matchers))
+	for i := range matchers {
+		mts = append(mts, Matcher{
+			FieldName: util.Pointer(matchers[i].FieldName),
+			Operator:  util.Pointer(matchers[i].Operator),
+			Value:     util.Pointer(matchers[i].Value),
+		})
+	}
+	return mts
+}
+
+func flattenAlertConfigurationMetricThresholdConfig(threshold *admin.FlexClusterMetricThreshold) *MetricThresholdView {
+	if threshold == nil {
+		return nil
+	}
+	return &MetricThresholdView{
+		MetricName: util.Pointer(threshold.MetricName),
+		Operator:   threshold.Operator,
+		Threshold:  threshold.Threshold,
+		Units:      threshold.Units,
+		Mode:       threshold.Mode,
+	}
+}
+
+func flattenAlertConfigurationThreshold(threshold *admin.StreamProcessorMetricThreshold) *IntegerThresholdView {
+	if threshold == nil {
+		return nil
+	}
+	return &IntegerThresholdView{
+		Operator:  threshold.Operator,
+		Threshold: threshold.Threshold,
+		Units:     threshold.Units,
+	}
+}
+
+func flattenAlertConfigurationNotification(notificationList []admin.AlertsNotificationRootForGroup, current []NotificationView) []NotificationView {
+	notifications := make([]NotificationView, 0, len(notificationList))
+	for i := range notificationList {
+		n := &notificationList[i]
+		var notification NotificationView
+		if i < len(current) {
+			notification = current[i]
+		}
+		notification.ChannelName = n.ChannelName
+		notification.DatadogRegion = n.DatadogRegion
+		notification.DelayMin = n.DelayMin
+		notification.EmailAddress = n.EmailAddress
+		notification.EmailEnabled = n.EmailEnabled
+		notification.IntegrationId = n.IntegrationId
+		notification.MobileNumber = n.MobileNumber
+		notification.NotifierId = n.NotifierId
+		notification.OpsGenieRegion = n.OpsGenieRegion
+		notification.RoomName = n.RoomName
+		notification.SmsEnabled = n.SmsEnabled
+		notification.TeamId = n.TeamId
+		notification.TeamName = n.TeamName
+		notification.TypeName = n.TypeName
+		notification.Username = n.Username
+		notification.IntervalMin = nil
+		if n.IntervalMin != nil {
+			notification.IntervalMin = util.Pointer(float64(*n.IntervalMin))
+		}
+		notification.Roles = nil
+		if n.Roles != nil {
+			notification.Roles = *n.Roles
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
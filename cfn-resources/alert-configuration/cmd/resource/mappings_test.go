@@ -0,0 +1,245 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/alert-configuration/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+const integrationID = "5f4d7a1b2c3e4f5a6b7c8d9e"
+
+func TestValidateAlertConfig(t *testing.T) {
+	tests := []struct {
+		model       *resource.Model
+		name        string
+		expectedErr string
+	}{
+		{
+			name: "Host Metric Threshold",
+			model: &resource.Model{
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				MetricThreshold: &resource.MetricThresholdView{MetricName: ptr.String("NORMALIZED_SYSTEM_CPU_USER"), Units: ptr.String("RAW")},
+				Notifications:   []resource.NotificationView{{TypeName: ptr.String("EMAIL"), IntervalMin: ptr.Float64(15)}},
+			},
+		},
+		{
+			name:        "Unknown Event Type",
+			model:       &resource.Model{EventTypeName: ptr.String("UNKNOWN_EVENT")},
+			expectedErr: "the event type UNKNOWN_EVENT is not supported, see the EventTypeName allowed values",
+		},
+		{
+			name: "Unknown Host Metric",
+			model: &resource.Model{
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				MetricThreshold: &resource.MetricThresholdView{MetricName: ptr.String("UNKNOWN_METRIC")},
+			},
+			expectedErr: "the metric UNKNOWN_METRIC is not a host metric",
+		},
+		{
+			name: "Units Not Matching Metric",
+			model: &resource.Model{
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				MetricThreshold: &resource.MetricThresholdView{MetricName: ptr.String("SYSTEM_NETWORK_IN"), Units: ptr.String("SECONDS")},
+			},
+			expectedErr: "the units SECONDS are not valid for the metric SYSTEM_NETWORK_IN, use one of: BITS, KILOBITS, MEGABITS, GIGABITS, BYTES, KILOBYTES, MEGABYTES, GIGABYTES, TERABYTES, PETABYTES",
+		},
+		{
+			name: "Interval On PagerDuty",
+			model: &resource.Model{
+				EventTypeName: ptr.String("HOST_DOWN"),
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("PAGER_DUTY"), IntervalMin: ptr.Float64(5)}},
+			},
+			expectedErr: "the notification interval doesn't need to be set if the notification type is 'PAGER_DUTY', 'OPS_GENIE' or 'VICTOR_OPS'",
+		},
+		{
+			name: "Interval Too Short",
+			model: &resource.Model{
+				EventTypeName: ptr.String("HOST_DOWN"),
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("EMAIL"), IntervalMin: ptr.Float64(1)}},
+			},
+			expectedErr: "the notification interval must be at least 5 minutes",
+		},
+		{
+			name: "Integration Notification",
+			model: &resource.Model{
+				EventTypeName: ptr.String("HOST_DOWN"),
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID)}},
+			},
+		},
+		{
+			name: "Integration With Unsupported Type",
+			model: &resource.Model{
+				EventTypeName: ptr.String("HOST_DOWN"),
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("EMAIL"), IntegrationId: ptr.String(integrationID)}},
+			},
+			expectedErr: "the integration 5f4d7a1b2c3e4f5a6b7c8d9e can't be used with notifications of type EMAIL",
+		},
+		{
+			name: "Integration With Credentials",
+			model: &resource.Model{
+				EventTypeName: ptr.String("HOST_DOWN"),
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("PAGER_DUTY"), IntegrationId: ptr.String(integrationID), ServiceKey: ptr.String("key")}},
+			},
+			expectedErr: "the credentials of the notification can't be set together with the integration 5f4d7a1b2c3e4f5a6b7c8d9e",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resource.ValidateAlertConfig(tc.model)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNewAlertConfigReq(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		expected *admin.GroupAlertsConfig
+		name     string
+	}{
+		{
+			name: "Metric Threshold With Integration",
+			model: &resource.Model{
+				ProjectId:       ptr.String("projectId"),
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				Enabled:         ptr.Bool(true),
+				Matchers:        []resource.Matcher{{FieldName: ptr.String("RULE_ID"), Operator: ptr.String("EQUALS"), Value: ptr.String("rule")}},
+				MetricThreshold: &resource.MetricThresholdView{MetricName: ptr.String("DISK_PARTITION_SPACE_USED_DATA"), Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(10), Units: ptr.String("GIGABYTES"), Mode: ptr.String("AVERAGE")},
+				Notifications: []resource.NotificationView{
+					{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID), DelayMin: ptr.Int(0), IntervalMin: ptr.Float64(30)},
+					{TypeName: ptr.String("GROUP"), NotifierId: ptr.String("notifierId"), Roles: []string{"GROUP_OWNER"}, EmailEnabled: ptr.Bool(true)},
+				},
+			},
+			expected: &admin.GroupAlertsConfig{
+				GroupId:         ptr.String("projectId"),
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				Enabled:         ptr.Bool(true),
+				Matchers:        &[]admin.StreamsMatcher{{FieldName: "RULE_ID", Operator: "EQUALS", Value: "rule"}},
+				MetricThreshold: &admin.FlexClusterMetricThreshold{MetricName: "DISK_PARTITION_SPACE_USED_DATA", Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(10), Units: ptr.String("GIGABYTES"), Mode: ptr.String("AVERAGE")},
+				Notifications: &[]admin.AlertsNotificationRootForGroup{
+					{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID), DelayMin: ptr.Int(0), IntervalMin: ptr.Int(30)},
+					{TypeName: ptr.String("GROUP"), NotifierId: ptr.String("notifierId"), Roles: &[]string{"GROUP_OWNER"}, EmailEnabled: ptr.Bool(true)},
+				},
+			},
+		},
+		{
+			name: "Threshold Without Interval",
+			model: &resource.Model{
+				ProjectId:     ptr.String("projectId"),
+				EventTypeName: ptr.String("TOO_MANY_ELECTIONS"),
+				Threshold:     &resource.IntegerThresholdView{Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(3), Units: ptr.String("RAW")},
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("PAGER_DUTY"), ServiceKey: ptr.String("key")}},
+			},
+			expected: &admin.GroupAlertsConfig{
+				GroupId:       ptr.String("projectId"),
+				EventTypeName: ptr.String("TOO_MANY_ELECTIONS"),
+				Matchers:      &[]admin.StreamsMatcher{},
+				Threshold:     &admin.StreamProcessorMetricThreshold{Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(3), Units: ptr.String("RAW")},
+				Notifications: &[]admin.AlertsNotificationRootForGroup{{TypeName: ptr.String("PAGER_DUTY"), ServiceKey: ptr.String("key")}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.NewAlertConfigReq(tc.model))
+		})
+	}
+}
+
+func TestGetAlertConfigModel(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		alertConfig  *admin.GroupAlertsConfig
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Metric Threshold With Integration",
+			alertConfig: &admin.GroupAlertsConfig{
+				Id:              ptr.String("alertConfigId"),
+				Created:         &created,
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				Enabled:         ptr.Bool(true),
+				Matchers:        &[]admin.StreamsMatcher{{FieldName: "RULE_ID", Operator: "EQUALS", Value: "rule"}},
+				MetricThreshold: &admin.FlexClusterMetricThreshold{MetricName: "DISK_PARTITION_SPACE_USED_DATA", Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(10), Units: ptr.String("GIGABYTES"), Mode: ptr.String("AVERAGE")},
+				Notifications: &[]admin.AlertsNotificationRootForGroup{
+					{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID), DelayMin: ptr.Int(0), IntervalMin: ptr.Int(30)},
+					{TypeName: ptr.String("PAGER_DUTY"), ServiceKey: ptr.String("****key"), NotifierId: ptr.String("notifierId"), DelayMin: ptr.Int(5), IntervalMin: ptr.Int(60)},
+				},
+			},
+			currentModel: &resource.Model{
+				ProjectId: ptr.String("projectId"),
+				Notifications: []resource.NotificationView{
+					{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID)},
+					{TypeName: ptr.String("PAGER_DUTY"), ServiceKey: ptr.String("service-key")},
+				},
+			},
+			expected: &resource.Model{
+				Id:              ptr.String("alertConfigId"),
+				Created:         ptr.String("2025-01-02T03:04:05Z"),
+				ProjectId:       ptr.String("projectId"),
+				EventTypeName:   ptr.String("OUTSIDE_METRIC_THRESHOLD"),
+				Enabled:         ptr.Bool(true),
+				Matchers:        []resource.Matcher{{FieldName: ptr.String("RULE_ID"), Operator: ptr.String("EQUALS"), Value: ptr.String("rule")}},
+				MetricThreshold: &resource.MetricThresholdView{MetricName: ptr.String("DISK_PARTITION_SPACE_USED_DATA"), Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(10), Units: ptr.String("GIGABYTES"), Mode: ptr.String("AVERAGE")},
+				Notifications: []resource.NotificationView{
+					{TypeName: ptr.String("SLACK"), IntegrationId: ptr.String(integrationID), DelayMin: ptr.Int(0), IntervalMin: ptr.Float64(30)},
+					{TypeName: ptr.String("PAGER_DUTY"), ServiceKey: ptr.String("service-key"), NotifierId: ptr.String("notifierId"), DelayMin: ptr.Int(5), IntervalMin: ptr.Float64(60)},
+				},
+			},
+		},
+		{
+			name: "Threshold",
+			alertConfig: &admin.GroupAlertsConfig{
+				Id:            ptr.String("alertConfigId"),
+				EventTypeName: ptr.String("TOO_MANY_ELECTIONS"),
+				Enabled:       ptr.Bool(false),
+				Matchers:      &[]admin.StreamsMatcher{},
+				Threshold:     &admin.StreamProcessorMetricThreshold{Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(3), Units: ptr.String("RAW")},
+				Notifications: &[]admin.AlertsNotificationRootForGroup{{TypeName: ptr.String("GROUP"), Roles: &[]string{"GROUP_OWNER"}, EmailEnabled: ptr.Bool(true)}},
+			},
+			currentModel: &resource.Model{ProjectId: ptr.String("projectId")},
+			expected: &resource.Model{
+				Id:            ptr.String("alertConfigId"),
+				ProjectId:     ptr.String("projectId"),
+				EventTypeName: ptr.String("TOO_MANY_ELECTIONS"),
+				Enabled:       ptr.Bool(false),
+				Threshold:     &resource.IntegerThresholdView{Operator: ptr.String("GREATER_THAN"), Threshold: ptr.Float64(3), Units: ptr.String("RAW")},
+				Notifications: []resource.NotificationView{{TypeName: ptr.String("GROUP"), Roles: []string{"GROUP_OWNER"}, EmailEnabled: ptr.Bool(true)}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetAlertConfigModel(tc.alertConfig, tc.currentModel))
+		})
+	}
+}
@@ -43,9 +43,11 @@ type NotificationView struct {
 	DelayMin                 *int     `json:",omitempty"`
 	EmailAddress             *string  `json:",omitempty"`
 	EmailEnabled             *bool    `json:",omitempty"`
+	IntegrationId            *string  `json:",omitempty"`
 	IntervalMin              *float64 `json:",omitempty"`
 	MicrosoftTeamsWebhookUrl *string  `json:",omitempty"`
 	MobileNumber             *string  `json:",omitempty"`
+	NotifierId               *string  `json:",omitempty"`
 	NotificationToken        *string  `json:",omitempty"`
 	OpsGenieApiKey           *string  `json:",omitempty"`
 	OpsGenieRegion           *string  `json:",omitempty"`
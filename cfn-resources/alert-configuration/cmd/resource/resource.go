@@ -16,17 +16,13 @@ package resource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 	"reflect"
-	"strings"
 
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/spf13/cast"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -63,7 +59,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if peErr != nil {
 		return *peErr, nil
 	}
-	atlasV2 := client.Atlas20231115014
+	atlasV2 := client.AtlasSDK
 
 	if currentModel.Id != nil && *currentModel.Id != "" {
 		_, _ = logger.Warnf("resource already exists for Id: %s", *currentModel.Id)
@@ -73,30 +69,17 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: string(types.HandlerErrorCodeAlreadyExists)}, nil
 	}
 
-	notifications, err := expandAlertConfigurationNotification(currentModel.Notifications)
-	if err != nil {
-		return progressevents.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), err
-	}
-
-	alertConfigRequest := admin20231115014.GroupAlertsConfig{
-		GroupId:         currentModel.ProjectId,
-		EventTypeName:   currentModel.EventTypeName,
-		Enabled:         currentModel.Enabled,
-		Matchers:        expandAlertConfigurationMatchers(currentModel.Matchers),
-		MetricThreshold: expandAlertConfigurationMetricThresholdConfig(currentModel),
-		Threshold:       expandAlertConfigurationThreshold(currentModel.Threshold),
-		Notifications:   notifications,
+	if err := ValidateAlertConfig(currentModel); err != nil {
+		return progressevents.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
 	projectID := *currentModel.ProjectId
-	var res *http.Response
-	alertConfig, res, err := atlasV2.AlertConfigurationsApi.CreateAlertConfiguration(context.Background(), projectID, &alertConfigRequest).Execute()
-	defer res.Body.Close()
+	alertConfig, res, err := atlasV2.AlertConfigurationsApi.CreateAlertConfig(context.Background(), projectID, NewAlertConfigReq(currentModel)).Execute()
 	if err != nil {
 		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
 	}
 
-	currentModel = convertToUIModel(alertConfig, currentModel)
+	currentModel = GetAlertConfigModel(alertConfig, currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -120,7 +103,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	if peErr != nil {
 		return *peErr, nil
 	}
-	atlasV2 := client.Atlas20231115014
+	atlasV2 := client.AtlasSDK
 
 	if !isExist(currentModel, atlasV2) {
 		_, _ = logger.Warnf("resource not exist for Id: %s", *currentModel.Id)
@@ -130,13 +113,12 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			HandlerErrorCode: string(types.HandlerErrorCodeNotFound)}, nil
 	}
 
-	alertConfig, resp, err := atlasV2.AlertConfigurationsApi.GetAlertConfiguration(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
-	defer resp.Body.Close()
+	alertConfig, resp, err := atlasV2.AlertConfigurationsApi.GetAlertConfig(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	if err != nil {
 		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
-	currentModel = convertToUIModel(alertConfig, currentModel)
+	currentModel = GetAlertConfigModel(alertConfig, currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -160,7 +142,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if peErr != nil {
 		return *peErr, nil
 	}
-	atlasV2 := client.Atlas20231115014
+	atlasV2 := client.AtlasSDK
 
 	if !isExist(currentModel, atlasV2) {
 		_, _ = logger.Warnf("resource not exist for Id: %s", *currentModel.Id)
@@ -170,11 +152,15 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: string(types.HandlerErrorCodeNotFound)}, nil
 	}
 
+	if err := ValidateAlertConfig(currentModel); err != nil {
+		return progressevents.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
 	// In order to update an alert config it is necessary to send the original alert configuration request again, if not the
 	// server returns an error 500
 	projectID := *currentModel.ProjectId
 	id := *currentModel.Id
-	alertReq, res, err := atlasV2.AlertConfigurationsApi.GetAlertConfiguration(context.Background(), projectID, id).Execute()
+	alertReq, res, err := atlasV2.AlertConfigurationsApi.GetAlertConfig(context.Background(), projectID, id).Execute()
 	if err != nil {
 		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
 	}
@@ -184,24 +170,23 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	// Removing the computed attributes to recreate the original request
 	alertReq.Created = nil
 	alertReq.Updated = nil
-	var alertModel *admin20231115014.GroupAlertsConfig
+	var alertModel *admin.GroupAlertsConfig
 
 	// Cannot enable/disable ONLY via update (if only send enable as changed field server returns a 500 error)
 	// so have to use different method to change enabled.
-	if reflect.DeepEqual(alertReq, &admin20231115014.GroupAlertsConfig{Enabled: aws.Bool(true)}) ||
-		reflect.DeepEqual(alertReq, &admin20231115014.GroupAlertsConfig{Enabled: aws.Bool(false)}) {
-		alertModel, res, err = atlasV2.AlertConfigurationsApi.ToggleAlertConfiguration(context.Background(), projectID, id, &admin20231115014.AlertsToggle{Enabled: alertReq.Enabled}).Execute()
+	if reflect.DeepEqual(alertReq, &admin.GroupAlertsConfig{Enabled: aws.Bool(true)}) ||
+		reflect.DeepEqual(alertReq, &admin.GroupAlertsConfig{Enabled: aws.Bool(false)}) {
+		alertModel, res, err = atlasV2.AlertConfigurationsApi.ToggleAlertConfig(context.Background(), projectID, id, &admin.AlertsToggle{Enabled: alertReq.Enabled}).Execute()
 	} else {
-		alertModel, res, err = atlasV2.AlertConfigurationsApi.UpdateAlertConfiguration(context.Background(), projectID, id, alertReq).Execute()
+		alertModel, res, err = atlasV2.AlertConfigurationsApi.UpdateAlertConfig(context.Background(), projectID, id, alertReq).Execute()
 	}
 
 	if err != nil {
 		_, _ = logger.Warnf("Update - error: %+v", err)
 		return progressevents.GetFailedEventByResponse(err.Error(), res), nil
 	}
-	defer res.Body.Close()
 
-	currentModel = convertToUIModel(alertModel, currentModel)
+	currentModel = GetAlertConfigModel(alertModel, currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -224,7 +209,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if peErr != nil {
 		return *peErr, nil
 	}
-	atlasV2 := client.Atlas20231115014
+	atlasV2 := client.AtlasSDK
 
 	if !isExist(currentModel, atlasV2) {
 		_, _ = logger.Warnf("resource not exist for Id: %s", *currentModel.Id)
@@ -234,7 +219,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			HandlerErrorCode: string(types.HandlerErrorCodeNotFound)}, nil
 	}
 
-	res, err := atlasV2.AlertConfigurationsApi.DeleteAlertConfiguration(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
+	res, err := atlasV2.AlertConfigurationsApi.DeleteAlertConfig(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 
 	if err != nil {
 		_, _ = logger.Warnf("Delete - error: %+v", err)
@@ -253,92 +238,14 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		HandlerErrorCode: string(types.HandlerErrorCodeNotFound)}, nil
 }
 
-func isExist(currentModel *Model, client *admin20231115014.APIClient) bool {
-	alert, _, err := client.AlertConfigurationsApi.GetAlertConfiguration(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
+func isExist(currentModel *Model, client *admin.APIClient) bool {
+	alert, _, err := client.AlertConfigurationsApi.GetAlertConfig(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
 	return err == nil && alert != nil
 }
 
-func expandAlertConfigurationMatchers(matchers []Matcher) *[]admin20231115014.StreamsMatcher {
-	mts := make([]admin20231115014.StreamsMatcher, 0)
-	for ind := range matchers {
-		mMatcher := admin20231115014.NewStreamsMatcher(
-			cast.ToString(matchers[ind].FieldName),
-			cast.ToString(matchers[ind].Operator),
-			cast.ToString(matchers[ind].Value),
-		)
-		mts = append(mts, *mMatcher)
-	}
-	return &mts
-}
-
-func expandAlertConfigurationMetricThresholdConfig(currentModel *Model) *admin20231115014.ServerlessMetricThreshold {
-	threshold := currentModel.MetricThreshold
-	if threshold == nil {
-		return nil
-	}
-	return &admin20231115014.ServerlessMetricThreshold{
-		MetricName: cast.ToString(threshold.MetricName),
-		Operator:   threshold.Operator,
-		Threshold:  threshold.Threshold,
-		Units:      threshold.Units,
-		Mode:       threshold.Mode,
-	}
-}
-
-func expandAlertConfigurationThreshold(threshold *IntegerThresholdView) *admin20231115014.GreaterThanRawThreshold {
-	if threshold == nil {
-		return nil
-	}
-	return &admin20231115014.GreaterThanRawThreshold{
-		Operator:  threshold.Operator,
-		Threshold: util.Pointer(int(*threshold.Threshold)),
-		Units:     threshold.Units,
-	}
-}
-
-func expandAlertConfigurationNotification(notificationList []NotificationView) (*[]admin20231115014.AlertsNotificationRootForGroup, error) {
-	notifications := make([]admin20231115014.AlertsNotificationRootForGroup, 0)
-
-	for ind := range notificationList {
-		if notificationList[ind].IntervalMin != nil && *notificationList[ind].IntervalMin > cast.ToFloat64(0) {
-			typeName := *notificationList[ind].TypeName
-			if strings.EqualFold(typeName, constants.PagerDuty) || strings.EqualFold(typeName, constants.OpsGenie) || strings.EqualFold(typeName, constants.VictorOps) {
-				return nil, fmt.Errorf(`'interval_min' doesn't need to be set if type_name is 'PAGER_DUTY', 'OPS_GENIE' or 'VICTOR_OPS'`)
-			}
-		}
-	}
-
-	for ind := range notificationList {
-		notification := admin20231115014.AlertsNotificationRootForGroup{
-			ApiToken:                 notificationList[ind].ApiToken,
-			ChannelName:              notificationList[ind].ChannelName,
-			DatadogApiKey:            notificationList[ind].DatadogApiKey,
-			DatadogRegion:            notificationList[ind].DatadogRegion,
-			EmailAddress:             notificationList[ind].EmailAddress,
-			EmailEnabled:             notificationList[ind].EmailEnabled,
-			IntervalMin:              util.Pointer(int(*notificationList[ind].IntervalMin)),
-			MicrosoftTeamsWebhookUrl: notificationList[ind].MicrosoftTeamsWebhookUrl,
-			MobileNumber:             notificationList[ind].MobileNumber,
-			OpsGenieApiKey:           notificationList[ind].OpsGenieApiKey,
-			OpsGenieRegion:           notificationList[ind].OpsGenieRegion,
-			ServiceKey:               notificationList[ind].ServiceKey,
-			SmsEnabled:               notificationList[ind].SmsEnabled,
-			TeamId:                   notificationList[ind].TeamId,
-			TypeName:                 notificationList[ind].TypeName,
-			Username:                 notificationList[ind].Username,
-			VictorOpsApiKey:          notificationList[ind].VictorOpsApiKey,
-			VictorOpsRoutingKey:      notificationList[ind].VictorOpsRoutingKey,
-			Roles:                    &notificationList[ind].Roles,
-			DelayMin:                 notificationList[ind].DelayMin,
-		}
-		notifications = append(notifications, notification)
-	}
-	return &notifications, nil
-}
-
-func convertToMongoModel(reqModel *admin20231115014.GroupAlertsConfig, currentModel *Model) *admin20231115014.GroupAlertsConfig {
+func convertToMongoModel(reqModel *admin.GroupAlertsConfig, currentModel *Model) *admin.GroupAlertsConfig {
 	if reqModel == nil {
-		reqModel = &admin20231115014.GroupAlertsConfig{}
+		reqModel = &admin.GroupAlertsConfig{}
 	}
 
 	// Only change the updated fields
@@ -352,30 +259,13 @@ func convertToMongoModel(reqModel *admin20231115014.GroupAlertsConfig, currentMo
 		reqModel.Matchers = expandAlertConfigurationMatchers(currentModel.Matchers)
 	}
 	if currentModel.MetricThreshold != nil {
-		reqModel.MetricThreshold = expandAlertConfigurationMetricThresholdConfig(currentModel)
+		reqModel.MetricThreshold = expandAlertConfigurationMetricThresholdConfig(currentModel.MetricThreshold)
 	}
 	if currentModel.Threshold != nil {
 		reqModel.Threshold = expandAlertConfigurationThreshold(currentModel.Threshold)
 	}
 	if currentModel.Notifications != nil {
-		reqModel.Notifications, _ = expandAlertConfigurationNotification(currentModel.Notifications)
+		reqModel.Notifications = expandAlertConfigurationNotification(currentModel.Notifications)
 	}
 	return reqModel
 }
-
-func convertToUIModel(alertConfig *admin20231115014.GroupAlertsConfig, currentModel *Model) *Model {
-	currentModel.Id = alertConfig.Id
-	if alertConfig.Created != nil {
-		currentModel.Created = util.TimePtrToStringPtr(alertConfig.Created)
-	}
-
-	if alertConfig.Updated != nil {
-		currentModel.Updated = util.TimePtrToStringPtr(alertConfig.Updated)
-	}
-
-	if alertConfig.Enabled != nil {
-		currentModel.Enabled = alertConfig.Enabled
-	}
-
-	return currentModel
-}
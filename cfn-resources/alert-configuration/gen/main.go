@@ -0,0 +1,151 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// This program generates cmd/resource/event_types.go from the Atlas Admin API specification bundled with the
+// Atlas Go SDK. Run it with `go generate ./...` after updating the SDK.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	sdkModule  = "go.mongodb.org/atlas-sdk/v20250312010"
+	outputFile = "event_types.go"
+)
+
+type schema struct {
+	Ref           string             `yaml:"$ref"`
+	Enum          []string           `yaml:"enum"`
+	OneOf         []schema           `yaml:"oneOf"`
+	AnyOf         []schema           `yaml:"anyOf"`
+	AllOf         []schema           `yaml:"allOf"`
+	Properties    map[string]schema  `yaml:"properties"`
+	Discriminator *discriminatorSpec `yaml:"discriminator"`
+}
+
+type discriminatorSpec struct {
+	Mapping map[string]string `yaml:"mapping"`
+}
+
+type spec struct {
+	Components struct {
+		Schemas map[string]schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+	if err != nil {
+		log.Fatalf("locating %s: %v", sdkModule, err)
+	}
+	content, err := os.ReadFile(filepath.Join(strings.TrimSpace(string(out)), "openapi", "atlas-api.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var s spec
+	if err := yaml.Unmarshal(content, &s); err != nil {
+		log.Fatal(err)
+	}
+	schemas := s.Components.Schemas
+
+	eventTypes := map[string]bool{}
+	for _, config := range schemas["GroupAlertsConfig"].OneOf {
+		for _, name := range enumValues(schemas, resolve(schemas, config).Properties["eventTypeName"]) {
+			eventTypes[name] = true
+		}
+	}
+
+	// The name of the threshold view of each host metric tells which family of units it's measured in.
+	metricUnits := map[string]string{}
+	for metric, ref := range schemas["HostMetricThreshold"].Discriminator.Mapping {
+		view := ref[strings.LastIndex(ref, "/")+1:]
+		for _, family := range []string{"Raw", "Data", "Time", "Number"} {
+			if strings.HasSuffix(view, family+"MetricThresholdView") {
+				metricUnits[metric] = family + "MetricUnits"
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen/main.go from the Atlas Admin API specification. DO NOT EDIT.\n\npackage resource\n\n")
+	buf.WriteString("// EventTypeNames contains the event types that can trigger a project alert.\nvar EventTypeNames = map[string]bool{\n")
+	for _, name := range sortedKeys(eventTypes) {
+		fmt.Fprintf(&buf, "%q: true,\n", name)
+	}
+	buf.WriteString("}\n\n// HostMetricUnits contains the units that the threshold of each host metric accepts.\nvar HostMetricUnits = map[string][]string{\n")
+	for _, metric := range sortedKeys(metricUnits) {
+		fmt.Fprintf(&buf, "%q: %s,\n", metric, strings.ToLower(metricUnits[metric][:1])+metricUnits[metric][1:])
+	}
+	buf.WriteString("}\n\n")
+	for _, family := range []string{"Raw", "Data", "Time", "Number"} {
+		name := family + "MetricUnits"
+		fmt.Fprintf(&buf, "var %s = []string{%s}\n\n", strings.ToLower(name[:1])+name[1:], quoted(schemas[name].Enum))
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(outputFile, formatted, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func resolve(schemas map[string]schema, s schema) schema {
+	for s.Ref != "" {
+		s = schemas[s.Ref[strings.LastIndex(s.Ref, "/")+1:]]
+	}
+	return s
+}
+
+func enumValues(schemas map[string]schema, s schema) []string {
+	s = resolve(schemas, s)
+	values := append([]string{}, s.Enum...)
+	for _, group := range [][]schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, sub := range group {
+			values = append(values, enumValues(schemas, sub)...)
+		}
+	}
+	return values
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func quoted(values []string) string {
+	q := make([]string, len(values))
+	for i, v := range values {
+		q[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(q, ", ")
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+)
+
+// ValidateAccessList checks that every entry sets either IpAddress or CidrBlock and that no entry is declared twice.
+func ValidateAccessList(entries []AccessListEntry) error {
+	keys := make(map[string]bool, len(entries))
+	for i := range entries {
+		if (entries[i].IpAddress == nil) == (entries[i].CidrBlock == nil) {
+			return errors.New("each AccessList entry requires either IpAddress or CidrBlock")
+		}
+		key := AccessListEntryKey(entries[i])
+		if keys[key] {
+			return fmt.Errorf("the AccessList entry %s is declared more than once", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+// AccessListEntryKey returns the address that identifies the entry in Atlas. A CIDR block with a /32 mask is
+// identified by its IP address.
+func AccessListEntryKey(entry AccessListEntry) string {
+	if entry.IpAddress != nil {
+		return *entry.IpAddress
+	}
+	if entry.CidrBlock == nil {
+		return ""
+	}
+	return strings.TrimSuffix(*entry.CidrBlock, "/32")
+}
+
+func NewAccessListReq(entries []AccessListEntry) *[]admin20231115014.UserAccessListRequest {
+	req := make([]admin20231115014.UserAccessListRequest, 0, len(entries))
+	for i := range entries {
+		req = append(req, admin20231115014.UserAccessListRequest{
+			IpAddress: entries[i].IpAddress,
+			CidrBlock: entries[i].CidrBlock,
+		})
+	}
+	return &req
+}
+
+// ToAccessListEntries converts the Atlas entries, using the IP address of the entries that have one.
+func ToAccessListEntries(results []admin20231115014.UserAccessListResponse) []AccessListEntry {
+	entries := make([]AccessListEntry, 0, len(results))
+	for i := range results {
+		entry := AccessListEntry{IpAddress: results[i].IpAddress}
+		if entry.IpAddress == nil {
+			entry.CidrBlock = results[i].CidrBlock
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GetAccessListModel returns the declared entries that exist in Atlas, keeping their declared form and comment since
+// Atlas doesn't store comments. Entries created outside of the resource, e.g. by AccessListAPIKey resources, aren't
+// returned.
+func GetAccessListModel(results []admin20231115014.UserAccessListResponse, declared []AccessListEntry) []AccessListEntry {
+	existingKeys := make(map[string]bool, len(results))
+	for _, entry := range ToAccessListEntries(results) {
+		existingKeys[AccessListEntryKey(entry)] = true
+	}
+	entries := make([]AccessListEntry, 0, len(declared))
+	for i := range declared {
+		if existingKeys[AccessListEntryKey(declared[i])] {
+			entries = append(entries, declared[i])
+		}
+	}
+	return entries
+}
+
+// GetChangesInAccessList returns the input entries missing from the existing access list, and the previously declared
+// entries that are no longer in the input but still exist. Entries the resource never declared are left unchanged,
+// as are entries only differing in their comment.
+func GetChangesInAccessList(inputEntries, previousEntries, existingEntries []AccessListEntry) (newEntries, removeEntries []AccessListEntry) {
+	existingKeys := make(map[string]bool, len(existingEntries))
+	for i := range existingEntries {
+		existingKeys[AccessListEntryKey(existingEntries[i])] = true
+	}
+	inputKeys := make(map[string]bool, len(inputEntries))
+	for i := range inputEntries {
+		key := AccessListEntryKey(inputEntries[i])
+		inputKeys[key] = true
+		// New Access List Entry
+		if !existingKeys[key] {
+			newEntries = append(newEntries, inputEntries[i])
+		}
+	}
+	for i := range previousEntries {
+		key := AccessListEntryKey(previousEntries[i])
+		// Removable Access List Entry
+		if !inputKeys[key] && existingKeys[key] {
+			removeEntries = append(removeEntries, previousEntries[i])
+		}
+	}
+	return newEntries, removeEntries
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/api-key/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAccessList(t *testing.T) {
+	tests := []struct {
+		entries     []resource.AccessListEntry
+		name        string
+		expectedErr string
+	}{
+		{
+			name: "Valid",
+			entries: []resource.AccessListEntry{
+				{IpAddress: ptr.String("192.0.2.1"), Comment: ptr.String("office")},
+				{CidrBlock: ptr.String("198.51.100.0/24")},
+			},
+		},
+		{
+			name:        "Missing Address",
+			entries:     []resource.AccessListEntry{{Comment: ptr.String("office")}},
+			expectedErr: "each AccessList entry requires either IpAddress or CidrBlock",
+		},
+		{
+			name:        "Both Addresses",
+			entries:     []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1"), CidrBlock: ptr.String("192.0.2.1/32")}},
+			expectedErr: "each AccessList entry requires either IpAddress or CidrBlock",
+		},
+		{
+			name: "Duplicated Entry",
+			entries: []resource.AccessListEntry{
+				{IpAddress: ptr.String("192.0.2.1")},
+				{CidrBlock: ptr.String("192.0.2.1/32")},
+			},
+			expectedErr: "the AccessList entry 192.0.2.1 is declared more than once",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resource.ValidateAccessList(tc.entries)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestGetAccessListModel(t *testing.T) {
+	results := []admin20231115014.UserAccessListResponse{
+		{IpAddress: ptr.String("192.0.2.1"), CidrBlock: ptr.String("192.0.2.1/32")},
+		{CidrBlock: ptr.String("198.51.100.0/24")},
+		{IpAddress: ptr.String("203.0.113.7"), CidrBlock: ptr.String("203.0.113.7/32")},
+	}
+	declared := []resource.AccessListEntry{
+		{CidrBlock: ptr.String("192.0.2.1/32"), Comment: ptr.String("office")},
+		{CidrBlock: ptr.String("198.51.100.0/24"), Comment: ptr.String("vpn")},
+		{IpAddress: ptr.String("192.0.2.99"), Comment: ptr.String("removed outside of the resource")},
+	}
+	expected := []resource.AccessListEntry{
+		{CidrBlock: ptr.String("192.0.2.1/32"), Comment: ptr.String("office")},
+		{CidrBlock: ptr.String("198.51.100.0/24"), Comment: ptr.String("vpn")},
+	}
+	assert.Equal(t, expected, resource.GetAccessListModel(results, declared))
+}
+
+func TestToAccessListEntries(t *testing.T) {
+	results := []admin20231115014.UserAccessListResponse{
+		{IpAddress: ptr.String("192.0.2.1"), CidrBlock: ptr.String("192.0.2.1/32")},
+		{CidrBlock: ptr.String("198.51.100.0/24")},
+	}
+	expected := []resource.AccessListEntry{
+		{IpAddress: ptr.String("192.0.2.1")},
+		{CidrBlock: ptr.String("198.51.100.0/24")},
+	}
+	assert.Equal(t, expected, resource.ToAccessListEntries(results))
+}
+
+func TestGetChangesInAccessList(t *testing.T) {
+	tests := []struct {
+		input          []resource.AccessListEntry
+		previous       []resource.AccessListEntry
+		existing       []resource.AccessListEntry
+		expectedNew    []resource.AccessListEntry
+		expectedRemove []resource.AccessListEntry
+		name           string
+	}{
+		{
+			name:        "Add Entries",
+			input:       []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {CidrBlock: ptr.String("198.51.100.0/24")}},
+			expectedNew: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {CidrBlock: ptr.String("198.51.100.0/24")}},
+		},
+		{
+			name:           "Replace Entry",
+			input:          []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {CidrBlock: ptr.String("198.51.100.0/24")}},
+			previous:       []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {IpAddress: ptr.String("203.0.113.7")}},
+			existing:       []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {IpAddress: ptr.String("203.0.113.7")}},
+			expectedNew:    []resource.AccessListEntry{{CidrBlock: ptr.String("198.51.100.0/24")}},
+			expectedRemove: []resource.AccessListEntry{{IpAddress: ptr.String("203.0.113.7")}},
+		},
+		{
+			name:     "Same Address In Other Form",
+			input:    []resource.AccessListEntry{{CidrBlock: ptr.String("192.0.2.1/32"), Comment: ptr.String("office")}},
+			previous: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+			existing: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+		},
+		{
+			name:           "Remove All Declared Entries",
+			previous:       []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+			existing:       []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {IpAddress: ptr.String("203.0.113.7")}},
+			expectedRemove: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+		},
+		{
+			name:     "Keep Entries Not Declared Before",
+			input:    []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+			previous: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+			existing: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}, {CidrBlock: ptr.String("198.51.100.0/24")}},
+		},
+		{
+			name:     "Skip Declared Entry Removed Outside",
+			previous: []resource.AccessListEntry{{IpAddress: ptr.String("192.0.2.1")}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			newEntries, removeEntries := resource.GetChangesInAccessList(tc.input, tc.previous, tc.existing)
+			assert.Equal(t, tc.expectedNew, newEntries)
+			assert.Equal(t, tc.expectedRemove, removeEntries)
+		})
+	}
+}
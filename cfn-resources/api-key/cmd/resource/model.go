@@ -14,6 +14,7 @@ type Model struct {
 	AwsSecretArn       *string             `json:",omitempty"`
 	Roles              []string            `json:",omitempty"`
 	ProjectAssignments []ProjectAssignment `json:",omitempty"`
+	AccessList         []AccessListEntry   `json:",omitempty"`
 	ListOptions        *ListOptions        `json:",omitempty"`
 }
 
@@ -23,6 +24,13 @@ type ProjectAssignment struct {
 	ProjectId *string  `json:",omitempty"`
 }
 
+// AccessListEntry is autogenerated from the json schema
+type AccessListEntry struct {
+	IpAddress *string `json:",omitempty"`
+	CidrBlock *string `json:",omitempty"`
+	Comment   *string `json:",omitempty"`
+}
+
 // ListOptions is autogenerated from the json schema
 type ListOptions struct {
 	PageNum      *int  `json:",omitempty"`
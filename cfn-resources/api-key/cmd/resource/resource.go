@@ -41,6 +41,8 @@ var ReadRequiredFields = []string{constants.OrgID, constants.APIUserID}
 var DeleteRequiredFields = []string{constants.OrgID, constants.APIUserID}
 var ListRequiredFields = []string{constants.OrgID}
 
+const defaultItemsPerPage = 100
+
 type APIKeySecret struct {
 	APIUserID  string
 	PublicKey  string
@@ -59,6 +61,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *modelValidation, nil
 	}
 
+	if err := ValidateAccessList(currentModel.AccessList); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
 	if currentModel.Profile == nil || *currentModel.Profile == "" {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
@@ -103,6 +109,18 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			}
 		}
 	}
+	// Add the declared access list entries to the new key
+	if len(currentModel.AccessList) > 0 {
+		_, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.CreateApiKeyAccessList(
+			context.Background(),
+			*currentModel.OrgId,
+			*currentModel.APIUserId,
+			NewAccessListReq(currentModel.AccessList),
+		).Execute()
+		if err != nil {
+			return handleError(response, constants.CREATE, err)
+		}
+	}
 	// writeOnly property not supposed to be in the response
 	currentModel.AwsSecretName = nil
 
@@ -136,6 +154,16 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}
 	currentModel.AwsSecretArn = arn
 	currentModel.readAPIKeyDetails(*apiKeyUserDetails)
+
+	// The access list is only read when declared, and only the declared entries are reported, so entries managed by
+	// AccessListAPIKey resources don't show up
+	if currentModel.AccessList != nil {
+		accessList, response, err := getAllAccessListEntries(atlas, currentModel)
+		if err != nil {
+			return handleError(response, constants.READ, err)
+		}
+		currentModel.AccessList = GetAccessListModel(accessList, currentModel.AccessList)
+	}
 	_, _ = logger.Debugf("Read Response: %+v", currentModel)
 
 	return handler.ProgressEvent{
@@ -152,6 +180,10 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *modelValidation, nil
 	}
 
+	if err := ValidateAccessList(currentModel.AccessList); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
 	if currentModel.Profile == nil || *currentModel.Profile == "" {
 		currentModel.Profile = aws.String(profile.DefaultProfile)
 	}
@@ -185,6 +217,14 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return handleError(response, constants.UPDATE, err)
 	}
 
+	// Reconcile the access list when it's declared, or when it was declared before so the removed entries are deleted
+	if currentModel.AccessList != nil || (prevModel != nil && prevModel.AccessList != nil) {
+		response, err = updateAccessList(client, prevModel, currentModel)
+		if err != nil {
+			return handleError(response, constants.UPDATE, err)
+		}
+	}
+
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Update Completed",
@@ -425,6 +465,66 @@ func getChangesInProjectAssignments(
 	return newAssignments, updateAssignments, removeAssignments
 }
 
+func getAllAccessListEntries(client *util.MongoDBClient, currentModel *Model) ([]admin20231115014.UserAccessListResponse, *http.Response, error) {
+	entries := make([]admin20231115014.UserAccessListResponse, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, response, err := client.Atlas20231115014.ProgrammaticAPIKeysApi.ListApiKeyAccessListsEntries(
+			context.Background(),
+			*currentModel.OrgId,
+			*currentModel.APIUserId,
+		).PageNum(pageNum).ItemsPerPage(defaultItemsPerPage).Execute()
+		if err != nil {
+			return nil, response, err
+		}
+		results := page.GetResults()
+		entries = append(entries, results...)
+		if len(results) == 0 || page.GetTotalCount() <= len(entries) {
+			return entries, nil, nil
+		}
+	}
+}
+
+// updateAccessList only removes the entries declared in the previous model, so entries created outside of the resource
+// are kept.
+func updateAccessList(client *util.MongoDBClient, prevModel, currentModel *Model) (*http.Response, error) {
+	accessList, response, err := getAllAccessListEntries(client, currentModel)
+	if err != nil {
+		return response, err
+	}
+	var previousEntries []AccessListEntry
+	if prevModel != nil {
+		previousEntries = prevModel.AccessList
+	}
+	newEntries, removeEntries := GetChangesInAccessList(currentModel.AccessList, previousEntries, ToAccessListEntries(accessList))
+
+	// Add Access List Entries
+	if len(newEntries) > 0 {
+		_, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.CreateApiKeyAccessList(
+			context.Background(),
+			*currentModel.OrgId,
+			*currentModel.APIUserId,
+			NewAccessListReq(newEntries),
+		).Execute()
+		if err != nil {
+			return response, err
+		}
+	}
+
+	// Remove Access List Entries
+	for i := range removeEntries {
+		_, response, err = client.Atlas20231115014.ProgrammaticAPIKeysApi.DeleteApiKeyAccessListEntry(
+			context.Background(),
+			*currentModel.OrgId,
+			*currentModel.APIUserId,
+			AccessListEntryKey(removeEntries[i]),
+		).Execute()
+		if err != nil {
+			return response, err
+		}
+	}
+	return response, nil
+}
+
 func areStringArraysEqualIgnoreOrder(arr1, arr2 []string) bool {
 	if len(arr1) != len(arr2) {
 		return false
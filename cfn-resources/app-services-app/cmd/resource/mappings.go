@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const (
+	// ClusterDataSourceType is the App Services service type of a linked Atlas cluster.
+	ClusterDataSourceType = "mongodb-atlas"
+	// ClusterDataSourceName is the name of the data source linked to the cluster when the application is created.
+	ClusterDataSourceName = "mongodb-atlas"
+)
+
+// App is the App Services Admin API representation of an application.
+type App struct {
+	DataSource      *AppDataSource `json:"data_source,omitempty"`
+	ID              string         `json:"_id,omitempty"`
+	ClientAppID     string         `json:"client_app_id,omitempty"`
+	Name            string         `json:"name"`
+	DeploymentModel string         `json:"deployment_model,omitempty"`
+	Location        string         `json:"location,omitempty"`
+	Environment     string         `json:"environment,omitempty"`
+}
+
+// AppDataSource is the data source linked to the application when it's created.
+type AppDataSource struct {
+	Config *AppDataSourceConfig `json:"config"`
+	Name   string               `json:"name"`
+	Type   string               `json:"type"`
+}
+
+// AppDataSourceConfig is the configuration of a linked Atlas cluster data source.
+type AppDataSourceConfig struct {
+	ClusterName string `json:"clusterName"`
+}
+
+// Service is the App Services Admin API representation of a service of the application, e.g. a linked cluster.
+type Service struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AppEnvironment is the request body to change the environment of an application.
+type AppEnvironment struct {
+	Environment string `json:"environment"`
+}
+
+// NewAppReq builds the create request of an application, linking the cluster when ClusterName is set.
+func NewAppReq(model *Model) *App {
+	app := &App{
+		Name:            util.SafeString(model.Name),
+		DeploymentModel: util.SafeString(model.DeploymentModel),
+		Location:        util.SafeString(model.Location),
+		Environment:     util.SafeString(model.Environment),
+	}
+	if clusterName := util.SafeString(model.ClusterName); clusterName != "" {
+		app.DataSource = &AppDataSource{
+			Name:   ClusterDataSourceName,
+			Type:   ClusterDataSourceType,
+			Config: &AppDataSourceConfig{ClusterName: clusterName},
+		}
+	}
+	return app
+}
+
+// GetAppModel maps an application to the model. ClusterName isn't returned by the API, so it's kept from the current model.
+func GetAppModel(app *App, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.ClusterName = currentModel.ClusterName
+	}
+	if app != nil {
+		model.AppId = util.StringPtr(app.ID)
+		model.ClientAppId = util.StringPtr(app.ClientAppID)
+		model.Name = util.StringPtr(app.Name)
+		model.DeploymentModel = util.StringPtr(app.DeploymentModel)
+		model.Location = util.StringPtr(app.Location)
+		model.Environment = util.StringPtr(app.Environment)
+	}
+	return model
+}
+
+// GetAppListModel maps an application returned by the App Services client to the model.
+func GetAppListModel(app *appservices.Application, currentModel *Model) *Model {
+	return GetAppModel(&App{
+		ID:              app.ID,
+		ClientAppID:     app.ClientAppID,
+		Name:            app.Name,
+		DeploymentModel: app.DeploymentModel,
+		Location:        app.Location,
+	}, currentModel)
+}
+
+// LinkedClusterServices returns the linked cluster services of the application, other services are ignored.
+func LinkedClusterServices(services []Service) []Service {
+	var linked []Service
+	for i := range services {
+		if services[i].Type == ClusterDataSourceType {
+			linked = append(linked, services[i])
+		}
+	}
+	return linked
+}
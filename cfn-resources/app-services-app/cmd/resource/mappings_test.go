@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/app-services-app/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAppReq(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		expected *resource.App
+		name     string
+	}{
+		{
+			name: "Without Cluster",
+			model: &resource.Model{
+				Name:            ptr.String("app"),
+				DeploymentModel: ptr.String("LOCAL"),
+				Location:        ptr.String("US-VA"),
+				Environment:     ptr.String("development"),
+			},
+			expected: &resource.App{
+				Name:            "app",
+				DeploymentModel: "LOCAL",
+				Location:        "US-VA",
+				Environment:     "development",
+			},
+		},
+		{
+			name: "With Cluster",
+			model: &resource.Model{
+				Name:        ptr.String("app"),
+				ClusterName: ptr.String("cluster"),
+			},
+			expected: &resource.App{
+				Name: "app",
+				DataSource: &resource.AppDataSource{
+					Name:   resource.ClusterDataSourceName,
+					Type:   resource.ClusterDataSourceType,
+					Config: &resource.AppDataSourceConfig{ClusterName: "cluster"},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.NewAppReq(tc.model))
+		})
+	}
+}
+
+func TestGetAppModel(t *testing.T) {
+	tests := []struct {
+		app          *resource.App
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Keeps Cluster Name",
+			app: &resource.App{
+				ID:              "111111111111111111111111",
+				ClientAppID:     "app-abcde",
+				Name:            "app",
+				DeploymentModel: "GLOBAL",
+				Location:        "US-VA",
+			},
+			currentModel: &resource.Model{
+				Profile:     ptr.String("default"),
+				ProjectId:   ptr.String("222222222222222222222222"),
+				ClusterName: ptr.String("cluster"),
+			},
+			expected: &resource.Model{
+				Profile:         ptr.String("default"),
+				ProjectId:       ptr.String("222222222222222222222222"),
+				ClusterName:     ptr.String("cluster"),
+				AppId:           ptr.String("111111111111111111111111"),
+				ClientAppId:     ptr.String("app-abcde"),
+				Name:            ptr.String("app"),
+				DeploymentModel: ptr.String("GLOBAL"),
+				Location:        ptr.String("US-VA"),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetAppModel(tc.app, tc.currentModel))
+		})
+	}
+}
+
+func TestLinkedClusterServices(t *testing.T) {
+	services := []resource.Service{
+		{ID: "1", Name: "mongodb-atlas", Type: resource.ClusterDataSourceType},
+		{ID: "2", Name: "http", Type: "http"},
+		{ID: "3", Name: "analytics", Type: resource.ClusterDataSourceType},
+	}
+	expected := []resource.Service{services[0], services[2]}
+	assert.Equal(t, expected, resource.LinkedClusterServices(services))
+	assert.Empty(t, resource.LinkedClusterServices(nil))
+}
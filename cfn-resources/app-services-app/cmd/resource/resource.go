@@ -0,0 +1,194 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	appsPath        = "groups/%s/apps"
+	servicesPath    = "services"
+	environmentPath = "environment"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.Name}
+var ReadRequiredFields = []string{constants.ProjectID, constants.AppID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.AppID}
+var ListRequiredFields = []string{constants.ProjectID}
+
+func initEnv(req handler.Request, currentModel *Model, requiredFields []string) (*appservices.Client, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-app-services-app")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, err := util.GetAppServicesClient(context.Background(), req, currentModel.Profile)
+	if err != nil {
+		pe := progress_events.GetFailedEventByCode(fmt.Sprintf("error creating App Services client: %s", err.Error()),
+			string(types.HandlerErrorCodeInvalidRequest))
+		return nil, &pe
+	}
+	return client, nil
+}
+
+// Create creates the application and, when ClusterName is set, links the cluster as the mongodb-atlas data source.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	app := new(App)
+	path := fmt.Sprintf(appsPath, *currentModel.ProjectId)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPost, path, NewAppReq(currentModel), app); err != nil {
+		return handleError(resp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   GetAppModel(app, currentModel),
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	app, resp, err := getApp(client, currentModel)
+	if err != nil {
+		return handleError(resp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetAppModel(app, currentModel),
+	}, nil
+}
+
+// Update changes the environment of the application, the only property that can be updated in place.
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, environmentPath)
+	body := &AppEnvironment{Environment: util.SafeString(currentModel.Environment)}
+	if resp, err := util.AppServicesRequest(ctx, client, http.MethodPut, path, body, nil); err != nil {
+		return handleError(resp, constants.UPDATE, err)
+	}
+
+	app, resp, err := getApp(client, currentModel)
+	if err != nil {
+		return handleError(resp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetAppModel(app, currentModel),
+	}, nil
+}
+
+// Delete removes the linked cluster services of the application before the application itself.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	var services []Service
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath)
+	if resp, err := util.AppServicesRequest(ctx, client, http.MethodGet, path, nil, &services); err != nil {
+		return handleError(resp, constants.DELETE, err)
+	}
+	for _, service := range LinkedClusterServices(services) {
+		servicePath := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath, service.ID)
+		if resp, err := util.AppServicesRequest(ctx, client, http.MethodDelete, servicePath, nil, nil); err != nil {
+			return handleError(resp, constants.DELETE, err)
+		}
+	}
+
+	path = util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId)
+	if resp, err := util.AppServicesRequest(ctx, client, http.MethodDelete, path, nil, nil); err != nil {
+		return handleError(resp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	apps, resp, err := client.Apps.List(context.Background(), *currentModel.ProjectId, nil)
+	if err != nil {
+		var httpResp *http.Response
+		if resp != nil {
+			httpResp = resp.Response
+		}
+		return handleError(httpResp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(apps))
+	for i := range apps {
+		models = append(models, GetAppListModel(&apps[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func getApp(client *appservices.Client, currentModel *Model) (*App, *http.Response, error) {
+	app := new(App)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId)
+	resp, err := util.AppServicesRequest(context.Background(), client, http.MethodGet, path, nil, app)
+	return app, resp, err
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// ClusterDataSourceType is the App Services service type of a linked Atlas cluster.
+const ClusterDataSourceType = "mongodb-atlas"
+
+// DataSource is the App Services Admin API representation of a data source (service).
+type DataSource struct {
+	Config *DataSourceConfig `json:"config,omitempty"`
+	ID     string            `json:"_id,omitempty"`
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
+}
+
+// DataSourceConfig is the configuration of a linked Atlas cluster data source.
+type DataSourceConfig struct {
+	ClusterName         string `json:"clusterName"`
+	ReadPreference      string `json:"readPreference,omitempty"`
+	WireProtocolEnabled bool   `json:"wireProtocolEnabled"`
+}
+
+// NewDataSourceConfig builds the configuration of the linked cluster.
+func NewDataSourceConfig(model *Model) *DataSourceConfig {
+	return &DataSourceConfig{
+		ClusterName:         util.SafeString(model.ClusterName),
+		ReadPreference:      util.SafeString(model.ReadPreference),
+		WireProtocolEnabled: aws.ToBool(model.WireProtocolEnabled),
+	}
+}
+
+// NewDataSourceReq builds the create request of a linked cluster data source.
+func NewDataSourceReq(model *Model) *DataSource {
+	return &DataSource{
+		Name:   util.SafeString(model.Name),
+		Type:   ClusterDataSourceType,
+		Config: NewDataSourceConfig(model),
+	}
+}
+
+// GetDataSourceModel maps a data source and its configuration to the model.
+func GetDataSourceModel(dataSource *DataSource, config *DataSourceConfig, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.AppId = currentModel.AppId
+	}
+	if dataSource != nil {
+		model.Id = util.StringPtr(dataSource.ID)
+		model.Name = util.StringPtr(dataSource.Name)
+	}
+	if config != nil {
+		model.ClusterName = util.StringPtr(config.ClusterName)
+		if config.ReadPreference != "" {
+			model.ReadPreference = util.StringPtr(config.ReadPreference)
+		}
+		model.WireProtocolEnabled = aws.Bool(config.WireProtocolEnabled)
+	}
+	return model
+}
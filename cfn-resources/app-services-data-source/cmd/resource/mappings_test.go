@@ -0,0 +1,90 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/app-services-data-source/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDataSourceReq(t *testing.T) {
+	model := &resource.Model{
+		Name:                ptr.String("mongodb-atlas"),
+		ClusterName:         ptr.String("cluster"),
+		ReadPreference:      ptr.String("secondary"),
+		WireProtocolEnabled: ptr.Bool(true),
+	}
+	expected := &resource.DataSource{
+		Name: "mongodb-atlas",
+		Type: resource.ClusterDataSourceType,
+		Config: &resource.DataSourceConfig{
+			ClusterName:         "cluster",
+			ReadPreference:      "secondary",
+			WireProtocolEnabled: true,
+		},
+	}
+	assert.Equal(t, expected, resource.NewDataSourceReq(model))
+}
+
+func TestGetDataSourceModel(t *testing.T) {
+	tests := []struct {
+		dataSource   *resource.DataSource
+		config       *resource.DataSourceConfig
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name:       "Without Config",
+			dataSource: &resource.DataSource{ID: "111111111111111111111111", Name: "mongodb-atlas", Type: resource.ClusterDataSourceType},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("222222222222222222222222"),
+				AppId:     ptr.String("333333333333333333333333"),
+			},
+			expected: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("222222222222222222222222"),
+				AppId:     ptr.String("333333333333333333333333"),
+				Id:        ptr.String("111111111111111111111111"),
+				Name:      ptr.String("mongodb-atlas"),
+			},
+		},
+		{
+			name:       "With Config",
+			dataSource: &resource.DataSource{ID: "111111111111111111111111", Name: "mongodb-atlas", Type: resource.ClusterDataSourceType},
+			config:     &resource.DataSourceConfig{ClusterName: "cluster", ReadPreference: "primary"},
+			expected: &resource.Model{
+				Id:                  ptr.String("111111111111111111111111"),
+				Name:                ptr.String("mongodb-atlas"),
+				ClusterName:         ptr.String("cluster"),
+				ReadPreference:      ptr.String("primary"),
+				WireProtocolEnabled: ptr.Bool(false),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetDataSourceModel(tc.dataSource, tc.config, tc.currentModel))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	servicesPath = "services"
+	configPath   = "config"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.Name, constants.ClusterName}
+var ReadRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID, constants.ClusterName}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var ListRequiredFields = []string{constants.ProjectID, constants.AppID}
+
+func initEnv(req handler.Request, currentModel *Model, requiredFields []string) (*appservices.Client, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-app-services-data-source")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, err := util.GetAppServicesClient(context.Background(), req, currentModel.Profile)
+	if err != nil {
+		pe := progress_events.GetFailedEventByCode(fmt.Sprintf("error creating App Services client: %s", err.Error()),
+			string(types.HandlerErrorCodeInvalidRequest))
+		return nil, &pe
+	}
+	return client, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	dataSource := new(DataSource)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPost, path, NewDataSourceReq(currentModel), dataSource); err != nil {
+		return handleError(resp, constants.CREATE, err)
+	}
+	currentModel.Id = util.StringPtr(dataSource.ID)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	dataSource := new(DataSource)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(ctx, client, http.MethodGet, path, nil, dataSource); err != nil {
+		return handleError(resp, constants.READ, err)
+	}
+	config := new(DataSourceConfig)
+	if resp, err := util.AppServicesRequest(ctx, client, http.MethodGet, path+"/"+configPath, nil, config); err != nil {
+		return handleError(resp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetDataSourceModel(dataSource, config, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath, *currentModel.Id, configPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPatch, path, NewDataSourceConfig(currentModel), nil); err != nil {
+		return handleError(resp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodDelete, path, nil, nil); err != nil {
+		return handleError(resp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+// List returns the linked cluster data sources of the application, other services are ignored.
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	var dataSources []DataSource
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, servicesPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodGet, path, nil, &dataSources); err != nil {
+		return handleError(resp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(dataSources))
+	for i := range dataSources {
+		if dataSources[i].Type == ClusterDataSourceType {
+			models = append(models, GetDataSourceModel(&dataSources[i], nil, currentModel))
+		}
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
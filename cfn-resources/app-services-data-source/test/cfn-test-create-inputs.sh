@@ -0,0 +1,42 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+set -euo pipefail
+
+function usage {
+	echo "usage:$0"
+	echo "Set the environment variables to use: 'export PROJECT_ID=<project_id> APP_ID=<app_id> CLUSTER_NAME=<cluster_name>'"
+}
+
+if [[ "$*" == help ]]; then usage; fi
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="default"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+projectId="${PROJECT_ID}"
+appId="${APP_ID}"
+clusterName="${CLUSTER_NAME}"
+
+cd "$(dirname "$0")" || exit
+for inputFile in inputs_*; do
+	outputFile=${inputFile//template./}
+	jq --arg ProjectId "$projectId" \
+		--arg AppId "$appId" \
+		--arg ClusterName "$clusterName" \
+		--arg profile "$profile" \
+		'.Profile?|=$profile | .ProjectId?|=$ProjectId | .AppId?|=$AppId | .ClusterName?|=$ClusterName' \
+		"$inputFile" >"../inputs/$outputFile"
+done
+cd ..
+
+ls -l inputs
@@ -0,0 +1,72 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// Function is the App Services Admin API representation of a function.
+type Function struct {
+	CanEvaluate any    `json:"can_evaluate,omitempty"`
+	ID          string `json:"_id,omitempty"`
+	Name        string `json:"name"`
+	Source      string `json:"source,omitempty"`
+	Private     bool   `json:"private"`
+	RunAsSystem bool   `json:"run_as_system"`
+}
+
+// NewFunctionReq builds the create or update request of a function, CanEvaluate must be a JSON expression.
+func NewFunctionReq(model *Model) (*Function, error) {
+	function := &Function{
+		Name:        util.SafeString(model.Name),
+		Source:      util.SafeString(model.Source),
+		Private:     aws.ToBool(model.Private),
+		RunAsSystem: aws.ToBool(model.RunAsSystem),
+	}
+	if util.IsStringPresent(model.CanEvaluate) {
+		if err := json.Unmarshal([]byte(*model.CanEvaluate), &function.CanEvaluate); err != nil {
+			return nil, fmt.Errorf("error unmarshalling CanEvaluate field: %s", err.Error())
+		}
+	}
+	return function, nil
+}
+
+// GetFunctionModel maps a function to the model. CanEvaluate is kept from the current model to avoid JSON formatting differences.
+func GetFunctionModel(function *Function, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.AppId = currentModel.AppId
+		model.CanEvaluate = currentModel.CanEvaluate
+	}
+	if function == nil {
+		return model
+	}
+	model.Id = util.StringPtr(function.ID)
+	model.Name = util.StringPtr(function.Name)
+	if function.Source != "" {
+		model.Source = util.StringPtr(function.Source)
+	}
+	model.Private = aws.Bool(function.Private)
+	model.RunAsSystem = aws.Bool(function.RunAsSystem)
+	return model
+}
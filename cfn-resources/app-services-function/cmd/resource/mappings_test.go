@@ -0,0 +1,124 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/app-services-function/cmd/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFunctionReq(t *testing.T) {
+	tests := []struct {
+		model         *resource.Model
+		expected      *resource.Function
+		name          string
+		expectedError bool
+	}{
+		{
+			name: "Defaults",
+			model: &resource.Model{
+				Name:   ptr.String("fn"),
+				Source: ptr.String("exports = function() {};"),
+			},
+			expected: &resource.Function{Name: "fn", Source: "exports = function() {};"},
+		},
+		{
+			name: "All Fields",
+			model: &resource.Model{
+				Name:        ptr.String("fn"),
+				Source:      ptr.String("exports = function() {};"),
+				Private:     ptr.Bool(true),
+				RunAsSystem: ptr.Bool(true),
+				CanEvaluate: ptr.String(`{"%%true": true}`),
+			},
+			expected: &resource.Function{
+				Name:        "fn",
+				Source:      "exports = function() {};",
+				Private:     true,
+				RunAsSystem: true,
+				CanEvaluate: map[string]any{"%%true": true},
+			},
+		},
+		{
+			name: "Invalid CanEvaluate",
+			model: &resource.Model{
+				Name:        ptr.String("fn"),
+				CanEvaluate: ptr.String("{invalid"),
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			function, err := resource.NewFunctionReq(tc.model)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, function)
+		})
+	}
+}
+
+func TestGetFunctionModel(t *testing.T) {
+	tests := []struct {
+		function     *resource.Function
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Get Function",
+			function: &resource.Function{
+				ID:          "111111111111111111111111",
+				Name:        "fn",
+				Source:      "exports = function() {};",
+				Private:     true,
+				CanEvaluate: map[string]any{},
+			},
+			currentModel: &resource.Model{
+				Profile:     ptr.String("default"),
+				ProjectId:   ptr.String("222222222222222222222222"),
+				AppId:       ptr.String("333333333333333333333333"),
+				CanEvaluate: ptr.String(`{ "%%true": true }`),
+			},
+			expected: &resource.Model{
+				Profile:     ptr.String("default"),
+				ProjectId:   ptr.String("222222222222222222222222"),
+				AppId:       ptr.String("333333333333333333333333"),
+				Id:          ptr.String("111111111111111111111111"),
+				Name:        ptr.String("fn"),
+				Source:      ptr.String("exports = function() {};"),
+				Private:     ptr.Bool(true),
+				RunAsSystem: ptr.Bool(false),
+				CanEvaluate: ptr.String(`{ "%%true": true }`),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetFunctionModel(tc.function, tc.currentModel))
+		})
+	}
+}
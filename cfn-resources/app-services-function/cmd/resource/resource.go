@@ -0,0 +1,174 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const functionsPath = "functions"
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.Name, constants.Source}
+var ReadRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID, constants.Name, constants.Source}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var ListRequiredFields = []string{constants.ProjectID, constants.AppID}
+
+func initEnv(req handler.Request, currentModel *Model, requiredFields []string) (*appservices.Client, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-app-services-function")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, err := util.GetAppServicesClient(context.Background(), req, currentModel.Profile)
+	if err != nil {
+		pe := progress_events.GetFailedEventByCode(fmt.Sprintf("error creating App Services client: %s", err.Error()),
+			string(types.HandlerErrorCodeInvalidRequest))
+		return nil, &pe
+	}
+	return client, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	functionReq, err := NewFunctionReq(currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+	function := new(Function)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, functionsPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPost, path, functionReq, function); err != nil {
+		return handleError(resp, constants.CREATE, err)
+	}
+	currentModel.Id = util.StringPtr(function.ID)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	function, resp, err := getFunction(client, currentModel)
+	if err != nil {
+		return handleError(resp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetFunctionModel(function, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	functionReq, err := NewFunctionReq(currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+	functionReq.ID = *currentModel.Id
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, functionsPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPut, path, functionReq, nil); err != nil {
+		return handleError(resp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, functionsPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodDelete, path, nil, nil); err != nil {
+		return handleError(resp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	var functions []Function
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, functionsPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodGet, path, nil, &functions); err != nil {
+		return handleError(resp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(functions))
+	for i := range functions {
+		models = append(models, GetFunctionModel(&functions[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func getFunction(client *appservices.Client, currentModel *Model) (*Function, *http.Response, error) {
+	function := new(Function)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, functionsPath, *currentModel.Id)
+	resp, err := util.AppServicesRequest(context.Background(), client, http.MethodGet, path, nil, function)
+	return function, resp, err
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// Secret is the App Services Admin API representation of a secret, the API never returns the secret value.
+type Secret struct {
+	ID    string `json:"_id,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// NewSecretReq builds the create or update request of a secret.
+func NewSecretReq(model *Model) *Secret {
+	return &Secret{
+		ID:    util.SafeString(model.Id),
+		Name:  util.SafeString(model.Name),
+		Value: util.SafeString(model.Value),
+	}
+}
+
+// GetSecretModel maps a secret to the model, Value is write-only and never returned.
+func GetSecretModel(secret *Secret, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.AppId = currentModel.AppId
+	}
+	if secret == nil {
+		return model
+	}
+	model.Id = util.StringPtr(secret.ID)
+	model.Name = util.StringPtr(secret.Name)
+	return model
+}
+
+// FindSecret returns the secret with the given ID, or nil if the application has no such secret.
+func FindSecret(secrets []Secret, id string) *Secret {
+	for i := range secrets {
+		if secrets[i].ID == id {
+			return &secrets[i]
+		}
+	}
+	return nil
+}
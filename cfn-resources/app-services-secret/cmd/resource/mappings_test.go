@@ -0,0 +1,48 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/app-services-secret/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSecretModel(t *testing.T) {
+	currentModel := &resource.Model{
+		Profile:   ptr.String("default"),
+		ProjectId: ptr.String("222222222222222222222222"),
+		AppId:     ptr.String("333333333333333333333333"),
+		Value:     ptr.String("secret"),
+	}
+	expected := &resource.Model{
+		Profile:   ptr.String("default"),
+		ProjectId: ptr.String("222222222222222222222222"),
+		AppId:     ptr.String("333333333333333333333333"),
+		Id:        ptr.String("111111111111111111111111"),
+		Name:      ptr.String("name"),
+	}
+	assert.Equal(t, new(resource.Model), resource.GetSecretModel(nil, nil))
+	assert.Equal(t, expected, resource.GetSecretModel(&resource.Secret{ID: "111111111111111111111111", Name: "name"}, currentModel))
+}
+
+func TestFindSecret(t *testing.T) {
+	secrets := []resource.Secret{{ID: "1", Name: "first"}, {ID: "2", Name: "second"}}
+	assert.Equal(t, &secrets[1], resource.FindSecret(secrets, "2"))
+	assert.Nil(t, resource.FindSecret(secrets, "3"))
+	assert.Nil(t, resource.FindSecret(nil, "1"))
+}
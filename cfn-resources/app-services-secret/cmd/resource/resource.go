@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const secretsPath = "secrets"
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.Name, constants.Value}
+var ReadRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID, constants.Name, constants.Value}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
+var ListRequiredFields = []string{constants.ProjectID, constants.AppID}
+
+func initEnv(req handler.Request, currentModel *Model, requiredFields []string) (*appservices.Client, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-app-services-secret")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, err := util.GetAppServicesClient(context.Background(), req, currentModel.Profile)
+	if err != nil {
+		pe := progress_events.GetFailedEventByCode(fmt.Sprintf("error creating App Services client: %s", err.Error()),
+			string(types.HandlerErrorCodeInvalidRequest))
+		return nil, &pe
+	}
+	return client, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	secret := new(Secret)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, secretsPath)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPost, path, NewSecretReq(currentModel), secret); err != nil {
+		return handleError(resp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   GetSecretModel(secret, currentModel),
+	}, nil
+}
+
+// Read looks the secret up in the list of secrets of the application: the API doesn't return a single secret.
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	secrets, resp, err := listSecrets(client, currentModel)
+	if err != nil {
+		return handleError(resp, constants.READ, err)
+	}
+	secret := FindSecret(secrets, *currentModel.Id)
+	if secret == nil {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("secret %s not found", *currentModel.Id),
+			string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetSecretModel(secret, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, secretsPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodPut, path, NewSecretReq(currentModel), nil); err != nil {
+		return handleError(resp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetSecretModel(&Secret{ID: *currentModel.Id, Name: *currentModel.Name}, currentModel),
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, secretsPath, *currentModel.Id)
+	if resp, err := util.AppServicesRequest(context.Background(), client, http.MethodDelete, path, nil, nil); err != nil {
+		return handleError(resp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	client, peErr := initEnv(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	secrets, resp, err := listSecrets(client, currentModel)
+	if err != nil {
+		return handleError(resp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(secrets))
+	for i := range secrets {
+		models = append(models, GetSecretModel(&secrets[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func listSecrets(client *appservices.Client, currentModel *Model) ([]Secret, *http.Response, error) {
+	var secrets []Secret
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, secretsPath)
+	resp, err := util.AppServicesRequest(context.Background(), client, http.MethodGet, path, nil, &secrets)
+	return secrets, resp, err
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
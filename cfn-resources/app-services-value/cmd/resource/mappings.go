@@ -0,0 +1,74 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// Value is the App Services Admin API representation of a value.
+type Value struct {
+	Value      any    `json:"value"`
+	ID         string `json:"_id,omitempty"`
+	Name       string `json:"name"`
+	FromSecret bool   `json:"from_secret"`
+	Private    bool   `json:"private"`
+}
+
+// NewValueReq builds the create or update request of a value. Valid JSON content is sent as JSON and any other content as a string,
+// a value that references a secret always contains the secret name.
+func NewValueReq(model *Model) *Value {
+	value := &Value{
+		Name:       util.SafeString(model.Name),
+		FromSecret: aws.ToBool(model.FromSecret),
+		Private:    aws.ToBool(model.Private),
+	}
+	content := util.SafeString(model.Value)
+	if value.FromSecret || !json.Valid([]byte(content)) || json.Unmarshal([]byte(content), &value.Value) != nil {
+		value.Value = content
+	}
+	return value
+}
+
+// GetValueModel maps a value to the model, JSON content is returned in its compact form.
+func GetValueModel(value *Value, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.AppId = currentModel.AppId
+	}
+	if value == nil {
+		return model
+	}
+	model.Id = util.StringPtr(value.ID)
+	model.Name = util.StringPtr(value.Name)
+	model.FromSecret = aws.Bool(value.FromSecret)
+	model.Private = aws.Bool(value.Private)
+	switch content := value.Value.(type) {
+	case nil:
+	case string:
+		model.Value = util.StringPtr(content)
+	default:
+		if b, err := json.Marshal(content); err == nil {
+			model.Value = util.StringPtr(string(b))
+		}
+	}
+	return model
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/app-services-value/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValueReq(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		expected *resource.Value
+		name     string
+	}{
+		{
+			name:     "String",
+			model:    &resource.Model{Name: ptr.String("value"), Value: ptr.String("text")},
+			expected: &resource.Value{Name: "value", Value: "text"},
+		},
+		{
+			name:     "JSON Number",
+			model:    &resource.Model{Name: ptr.String("value"), Value: ptr.String("42"), Private: ptr.Bool(true)},
+			expected: &resource.Value{Name: "value", Value: float64(42), Private: true},
+		},
+		{
+			name:     "JSON Object",
+			model:    &resource.Model{Name: ptr.String("value"), Value: ptr.String(`{"key": ["a", "b"]}`)},
+			expected: &resource.Value{Name: "value", Value: map[string]any{"key": []any{"a", "b"}}},
+		},
+		{
+			name:     "From Secret",
+			model:    &resource.Model{Name: ptr.String("value"), Value: ptr.String(`"secretName"`), FromSecret: ptr.Bool(true)},
+			expected: &resource.Value{Name: "value", Value: `"secretName"`, FromSecret: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.NewValueReq(tc.model))
+		})
+	}
+}
+
+func TestGetValueModel(t *testing.T) {
+	tests := []struct {
+		value        *resource.Value
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name:  "String",
+			value: &resource.Value{ID: "111111111111111111111111", Name: "value", Value: "text"},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("222222222222222222222222"),
+				AppId:     ptr.String("333333333333333333333333"),
+			},
+			expected: &resource.Model{
+				Profile:    ptr.String("default"),
+				ProjectId:  ptr.String("222222222222222222222222"),
+				AppId:      ptr.String("333333333333333333333333"),
+				Id:         ptr.String("111111111111111111111111"),
+				Name:       ptr.String("value"),
+				Value:      ptr.String("text"),
+				FromSecret: ptr.Bool(false),
+				Private:    ptr.Bool(false),
+			},
+		},
+		{
+			name:  "JSON Object",
+			value: &resource.Value{ID: "111111111111111111111111", Name: "value", Value: map[string]any{"key": []any{"a", "b"}}, Private: true},
+			expected: &resource.Model{
+				Id:         ptr.String("111111111111111111111111"),
+				Name:       ptr.String("value"),
+				Value:      ptr.String(`{"key":["a","b"]}`),
+				FromSecret: ptr.Bool(false),
+				Private:    ptr.Bool(true),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetValueModel(tc.value, tc.currentModel))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+func GetClusterFailoverTestModel(cluster *admin.ClusterDescription20240805, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model = currentModel
+	}
+	if cluster == nil {
+		return model
+	}
+	model.ClusterName = cluster.Name
+	model.StateName = cluster.StateName
+	return model
+}
+
+// MaxIdlePolls is the number of polls that all see the cluster IDLE after which the failover is considered completed,
+// as a restart that finishes within one poll interval never shows another state.
+const MaxIdlePolls = 4
+
+// IsFailoverCompleted returns true once the cluster is back to IDLE after the primaries have been restarted.
+// leftIdle must be true once the cluster has been seen in a state other than IDLE, as the first polls can
+// still see IDLE before the restart has started. idlePolls counts the polls that saw the cluster IDLE, so the
+// failover also completes if the cluster never leaves IDLE.
+func IsFailoverCompleted(cluster *admin.ClusterDescription20240805, leftIdle bool, idlePolls int) bool {
+	return cluster.GetStateName() == constants.IdleState && (leftIdle || idlePolls >= MaxIdlePolls)
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster-failover-test/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClusterFailoverTestModel(t *testing.T) {
+	tests := []struct {
+		input        *admin.ClusterDescription20240805
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Cluster",
+			input: &admin.ClusterDescription20240805{
+				Name:      ptr.String("cluster"),
+				StateName: ptr.String("REPAIRING"),
+			},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+			},
+			expected: &resource.Model{
+				Profile:     ptr.String("default"),
+				ProjectId:   ptr.String("111111111111111111111111"),
+				ClusterName: ptr.String("cluster"),
+				StateName:   ptr.String("REPAIRING"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetClusterFailoverTestModel(tt.input, tt.currentModel)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestIsFailoverCompleted(t *testing.T) {
+	tests := []struct {
+		input     *admin.ClusterDescription20240805
+		name      string
+		idlePolls int
+		leftIdle  bool
+		expected  bool
+	}{
+		{
+			name:     "Nil Input",
+			leftIdle: true,
+			expected: false,
+		},
+		{
+			name:     "Repairing",
+			input:    &admin.ClusterDescription20240805{StateName: ptr.String("REPAIRING")},
+			leftIdle: true,
+			expected: false,
+		},
+		{
+			name:      "Idle Before Restart Started",
+			input:     &admin.ClusterDescription20240805{StateName: ptr.String("IDLE")},
+			idlePolls: 1,
+			leftIdle:  false,
+			expected:  false,
+		},
+		{
+			name:      "Idle After Restart",
+			input:     &admin.ClusterDescription20240805{StateName: ptr.String("IDLE")},
+			idlePolls: 1,
+			leftIdle:  true,
+			expected:  true,
+		},
+		{
+			name:      "Never Leaves Idle",
+			input:     &admin.ClusterDescription20240805{StateName: ptr.String("IDLE")},
+			idlePolls: resource.MaxIdlePolls,
+			leftIdle:  false,
+			expected:  true,
+		},
+		{
+			name:      "Repairing After Idle Polls",
+			input:     &admin.ClusterDescription20240805{StateName: ptr.String("REPAIRING")},
+			idlePolls: resource.MaxIdlePolls,
+			leftIdle:  true,
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.IsFailoverCompleted(tt.input, tt.leftIdle, tt.idlePolls))
+		})
+	}
+}
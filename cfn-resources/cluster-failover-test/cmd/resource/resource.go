@@ -0,0 +1,175 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const callBackSeconds = 30
+
+var RequiredFields = []string{constants.ProjectID, constants.ClusterName}
+
+const (
+	callbackFailoverTest = "callbackFailoverTest"
+	callbackLeftIdle     = "leftIdle"
+	callbackIdlePolls    = "idlePolls"
+)
+
+func isCallback(req *handler.Request) bool {
+	_, found := req.CallbackContext[callbackFailoverTest]
+	return found
+}
+
+func hasLeftIdle(req *handler.Request) bool {
+	leftIdle, _ := req.CallbackContext[callbackLeftIdle].(bool)
+	return leftIdle
+}
+
+// getIdlePolls returns the number of polls that saw the cluster IDLE, the callback context stores numbers as float64
+// once it has been serialized.
+func getIdlePolls(req *handler.Request) int {
+	switch idlePolls := req.CallbackContext[callbackIdlePolls].(type) {
+	case float64:
+		return int(idlePolls)
+	case int:
+		return idlePolls
+	default:
+		return 0
+	}
+}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-cluster-failover-test")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	if isCallback(&req) {
+		cluster, apiResp, err := conn.ClustersApi.GetCluster(ctx, *currentModel.ProjectId, *currentModel.ClusterName).Execute()
+		if err != nil {
+			return handleError(apiResp, constants.CREATE, err)
+		}
+		resourceModel := GetClusterFailoverTestModel(cluster, currentModel)
+		leftIdle := hasLeftIdle(&req) || cluster.GetStateName() != constants.IdleState
+		idlePolls := getIdlePolls(&req)
+		if cluster.GetStateName() == constants.IdleState {
+			idlePolls++
+		}
+		if !IsFailoverCompleted(cluster, leftIdle, idlePolls) {
+			return inProgressEvent(resourceModel, leftIdle, idlePolls), nil
+		}
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         "Create Completed",
+			ResourceModel:   resourceModel,
+		}, nil
+	}
+
+	if apiResp, err := conn.ClustersApi.RestartPrimaries(ctx, *currentModel.ProjectId, *currentModel.ClusterName).Execute(); err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	return inProgressEvent(currentModel, false, 0), nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	cluster, apiResp, err := conn.ClustersApi.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   GetClusterFailoverTestModel(cluster, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: Update")
+}
+
+// Delete doesn't call Atlas beyond checking the cluster exists: a failover test can't be undone.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if _, apiResp, err := conn.ClustersApi.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: List")
+}
+
+func inProgressEvent(model *Model, leftIdle bool, idlePolls int) handler.ProgressEvent {
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              fmt.Sprintf("Testing failover %s", util.SafeString(model.StateName)),
+		ResourceModel:        model,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext: map[string]any{
+			callbackFailoverTest: true,
+			callbackLeftIdle:     leftIdle,
+			callbackIdlePolls:    idlePolls,
+		},
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
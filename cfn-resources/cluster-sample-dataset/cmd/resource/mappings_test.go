@@ -0,0 +1,92 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster-sample-dataset/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClusterSampleDatasetModel(t *testing.T) {
+	createDate := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	completeDate := createDate.Add(10 * time.Minute)
+
+	tests := []struct {
+		input        *admin.SampleDatasetStatus
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Working",
+			input: &admin.SampleDatasetStatus{
+				Id:          ptr.String("111111111111111111111111"),
+				ClusterName: ptr.String("cluster"),
+				State:       ptr.String(resource.StateWorking),
+				CreateDate:  &createDate,
+			},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("222222222222222222222222"),
+			},
+			expected: &resource.Model{
+				Profile:     ptr.String("default"),
+				ProjectId:   ptr.String("222222222222222222222222"),
+				ClusterName: ptr.String("cluster"),
+				Id:          ptr.String("111111111111111111111111"),
+				State:       ptr.String(resource.StateWorking),
+				CreateDate:  ptr.String("2025-01-02T03:04:05Z"),
+			},
+		},
+		{
+			name: "Failed",
+			input: &admin.SampleDatasetStatus{
+				Id:           ptr.String("111111111111111111111111"),
+				State:        ptr.String(resource.StateFailed),
+				CreateDate:   &createDate,
+				CompleteDate: &completeDate,
+				ErrorMessage: ptr.String("not enough disk space"),
+			},
+			currentModel: &resource.Model{
+				ClusterName: ptr.String("cluster"),
+			},
+			expected: &resource.Model{
+				ClusterName:  ptr.String("cluster"),
+				Id:           ptr.String("111111111111111111111111"),
+				State:        ptr.String(resource.StateFailed),
+				CreateDate:   ptr.String("2025-01-02T03:04:05Z"),
+				CompleteDate: ptr.String("2025-01-02T03:14:05Z"),
+				ErrorMessage: ptr.String("not enough disk space"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetClusterSampleDatasetModel(tt.input, tt.currentModel)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const callBackSeconds = 30
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.ClusterName}
+var ReadRequiredFields = []string{constants.ProjectID, constants.ID}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.ID}
+
+var callbackContext = map[string]any{"callbackSampleDataset": true}
+
+func isCallback(req *handler.Request) bool {
+	_, found := req.CallbackContext["callbackSampleDataset"]
+	return found
+}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-cluster-sample-dataset")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if isCallback(&req) {
+		status, apiResp, err := conn.ClustersApi.GetSampleDatasetLoad(context.Background(), *currentModel.ProjectId, util.SafeString(currentModel.Id)).Execute()
+		if err != nil {
+			return handleError(apiResp, constants.CREATE, err)
+		}
+		return loadProgressEvent(GetClusterSampleDatasetModel(status, currentModel)), nil
+	}
+
+	status, apiResp, err := conn.ClustersApi.RequestSampleDatasetLoad(context.Background(), *currentModel.ProjectId, *currentModel.ClusterName).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	return loadProgressEvent(GetClusterSampleDatasetModel(status, currentModel)), nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	status, apiResp, err := conn.ClustersApi.GetSampleDatasetLoad(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   GetClusterSampleDatasetModel(status, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: Update")
+}
+
+// Delete only forgets the sample dataset load: Atlas doesn't offer an API to remove the loaded data.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if _, apiResp, err := conn.ClustersApi.GetSampleDatasetLoad(context.Background(), *currentModel.ProjectId, *currentModel.Id).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: List")
+}
+
+func loadProgressEvent(model *Model) handler.ProgressEvent {
+	switch util.SafeString(model.State) {
+	case StateCompleted:
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         "Create Completed",
+			ResourceModel:   model,
+		}
+	case StateFailed:
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("sample dataset load failed: %s", util.SafeString(model.ErrorMessage)),
+			string(types.HandlerErrorCodeGeneralServiceException))
+	default:
+		return handler.ProgressEvent{
+			OperationStatus:      handler.InProgress,
+			Message:              fmt.Sprintf("Loading sample dataset %s", util.SafeString(model.State)),
+			ResourceModel:        model,
+			CallbackDelaySeconds: callBackSeconds,
+			CallbackContext:      callbackContext,
+		}
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
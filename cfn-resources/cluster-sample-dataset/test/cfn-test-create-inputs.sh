@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+set -euo pipefail
+
+function usage {
+	echo "usage:$0 <project_name>"
+	echo "Creates a new project and a cluster for testing"
+}
+
+if [ "$#" -ne 1 ]; then usage; fi
+if [[ "$*" == help ]]; then usage; fi
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="default"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+projectName="${1}"
+clusterName=${projectName}
+
+projectId=$(atlas projects list --output json | jq --arg NAME "${projectName}" -r '.results[] | select(.name==$NAME) | .id')
+if [ -z "$projectId" ]; then
+	projectId=$(atlas projects create "${projectName}" --output=json | jq -r '.id')
+
+	echo -e "Created project \"${projectName}\" with id: ${projectId}\n"
+else
+	echo -e "FOUND project \"${projectName}\" with id: ${projectId}\n"
+fi
+
+atlas clusters create "${clusterName}" --projectId "${projectId}" -f "$(dirname "$0")/cluster.json" --output=json
+atlas clusters watch "${clusterName}" --projectId "${projectId}"
+echo -e "Created Cluster \"${clusterName}\""
+
+jq --arg ProjectId "$projectId" \
+	--arg ClusterName "$clusterName" \
+	--arg profile "$profile" \
+	'.Profile?|=$profile | .ProjectId?|=$ProjectId | .ClusterName?|=$ClusterName' \
+	"$(dirname "$0")/inputs_1_create.template.json" >"inputs/inputs_1_create.json"
+
+ls -l inputs
@@ -19,7 +19,7 @@ import (
 	"fmt"
 	"reflect"
 
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -29,7 +29,7 @@ import (
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
 )
 
-func mapClusterToModel(model *Model, cluster *admin20231115014.AdvancedClusterDescription) {
+func mapClusterToModel(model *Model, cluster *admin.ClusterDescription20240805) {
 	model.Id = cluster.Id
 	model.ProjectId = cluster.GroupId
 	model.Name = cluster.Name
@@ -38,16 +38,17 @@ func mapClusterToModel(model *Model, cluster *admin20231115014.AdvancedClusterDe
 	model.ConnectionStrings = flattenConnectionStrings(cluster.ConnectionStrings)
 	model.ClusterType = cluster.ClusterType
 	model.CreatedDate = util.TimePtrToStringPtr(cluster.CreateDate)
-	model.DiskSizeGB = cluster.DiskSizeGB
+	model.DiskSizeGB = getDiskSizeGB(cluster.GetReplicationSpecs())
 	model.EncryptionAtRestProvider = cluster.EncryptionAtRestProvider
 	model.GlobalClusterSelfManagedSharding = cluster.GlobalClusterSelfManagedSharding
 	model.Labels = flattenLabels(cluster.GetLabels())
 	model.MongoDBMajorVersion = cluster.MongoDBMajorVersion
 	model.MongoDBVersion = cluster.MongoDBVersion
 	model.Paused = cluster.Paused
+	model.PinnedFCV = GetPinnedFCVModel(cluster)
 	model.PitEnabled = cluster.PitEnabled
 	model.RootCertType = cluster.RootCertType
-	model.ReplicationSpecs = flattenReplicationSpecs(cluster.GetReplicationSpecs())
+	model.ReplicationSpecs = GetReplicationSpecsModel(cluster.GetReplicationSpecs())
 	model.StateName = cluster.StateName
 	model.VersionReleaseSystem = cluster.VersionReleaseSystem
 }
@@ -62,51 +63,51 @@ func containsLabelOrKey(list []Labels, item Labels) bool {
 	return false
 }
 
-func expandBiConnector(biConnector *BiConnector) *admin20231115014.BiConnector {
+func expandBiConnector(biConnector *BiConnector) *admin.BiConnector {
 	if biConnector == nil {
 		return nil
 	}
-	return &admin20231115014.BiConnector{
+	return &admin.BiConnector{
 		Enabled:        biConnector.Enabled,
 		ReadPreference: biConnector.ReadPreference,
 	}
 }
 
-func expandReplicationSpecs(replicationSpecs []AdvancedReplicationSpec) []admin20231115014.ReplicationSpec {
-	rSpecs := []admin20231115014.ReplicationSpec{}
+// NewReplicationSpecs returns one replication spec per shard, as expected by the independent-shard API.
+// A spec with NumShards greater than one is expanded to that number of identical shards; list the shards
+// separately to deploy asymmetric shards, e.g. with different instance sizes.
+func NewReplicationSpecs(replicationSpecs []AdvancedReplicationSpec, diskSizeGB *float64) []admin.ReplicationSpec20240805 {
+	rSpecs := []admin.ReplicationSpec20240805{}
 
 	for i := range replicationSpecs {
-		var numShards int
-
-		rSpec := admin20231115014.ReplicationSpec{
-			NumShards:     &numShards,
-			RegionConfigs: expandRegionsConfig(replicationSpecs[i].AdvancedRegionConfigs),
-		}
-
-		if util.IsStringPresent(replicationSpecs[i].ID) {
-			rSpec.Id = admin20231115014.PtrString(cast.ToString(replicationSpecs[i].ID))
+		numShards := 1
+		if replicationSpecs[i].NumShards != nil && *replicationSpecs[i].NumShards > 1 {
+			numShards = *replicationSpecs[i].NumShards
 		}
-
-		if replicationSpecs[i].NumShards != nil {
-			rSpec.NumShards = replicationSpecs[i].NumShards
-		}
-		if replicationSpecs[i].ZoneName != nil {
-			rSpec.ZoneName = admin20231115014.PtrString(cast.ToString(replicationSpecs[i].ZoneName))
+		for shard := 0; shard < numShards; shard++ {
+			rSpec := admin.ReplicationSpec20240805{
+				RegionConfigs: expandRegionsConfig(replicationSpecs[i].AdvancedRegionConfigs, diskSizeGB),
+			}
+			// The ID identifies a single shard, it can only be assigned to the first shard of the spec
+			if shard == 0 && util.IsStringPresent(replicationSpecs[i].ID) {
+				rSpec.Id = admin.PtrString(cast.ToString(replicationSpecs[i].ID))
+			}
+			if replicationSpecs[i].ZoneName != nil {
+				rSpec.ZoneName = admin.PtrString(cast.ToString(replicationSpecs[i].ZoneName))
+			}
+			rSpecs = append(rSpecs, rSpec)
 		}
-		rSpecs = append(rSpecs, rSpec)
 	}
-
-	fmt.Printf("specs: len %d %+v", len(replicationSpecs), rSpecs)
 	return rSpecs
 }
 
-func expandAutoScaling(scaling *AdvancedAutoScaling) *admin20231115014.AdvancedAutoScalingSettings {
-	advAutoScaling := &admin20231115014.AdvancedAutoScalingSettings{}
+func expandAutoScaling(scaling *AdvancedAutoScaling) *admin.AdvancedAutoScalingSettings {
+	advAutoScaling := &admin.AdvancedAutoScalingSettings{}
 	if scaling == nil {
 		return nil
 	}
 	if scaling.Compute != nil {
-		advAutoScaling.Compute = &admin20231115014.AdvancedComputeAutoScaling{
+		advAutoScaling.Compute = &admin.AdvancedComputeAutoScaling{
 			Enabled:          scaling.Compute.Enabled,
 			ScaleDownEnabled: scaling.Compute.ScaleDownEnabled,
 		}
@@ -121,27 +122,27 @@ func expandAutoScaling(scaling *AdvancedAutoScaling) *admin20231115014.AdvancedA
 	}
 
 	if scaling.DiskGB != nil {
-		advAutoScaling.DiskGB = &admin20231115014.DiskGBAutoScaling{Enabled: scaling.DiskGB.Enabled}
+		advAutoScaling.DiskGB = &admin.DiskGBAutoScaling{Enabled: scaling.DiskGB.Enabled}
 	}
 
 	return advAutoScaling
 }
 
-func expandRegionsConfig(regionConfigs []AdvancedRegionConfig) *[]admin20231115014.CloudRegionConfig {
-	regionsConfigs := []admin20231115014.CloudRegionConfig{}
+func expandRegionsConfig(regionConfigs []AdvancedRegionConfig, diskSizeGB *float64) *[]admin.CloudRegionConfig20240805 {
+	regionsConfigs := []admin.CloudRegionConfig20240805{}
 	for _, regionCfg := range regionConfigs {
-		regionsConfigs = append(regionsConfigs, expandRegionConfig(regionCfg))
+		regionsConfigs = append(regionsConfigs, expandRegionConfig(regionCfg, diskSizeGB))
 	}
 	return &regionsConfigs
 }
 
-func expandRegionConfig(regionCfg AdvancedRegionConfig) admin20231115014.CloudRegionConfig {
+func expandRegionConfig(regionCfg AdvancedRegionConfig, diskSizeGB *float64) admin.CloudRegionConfig20240805 {
 	providerName := constants.AWS
 	if regionCfg.ProviderName != nil {
 		providerName = *regionCfg.ProviderName
 	}
 
-	advRegionConfig := admin20231115014.CloudRegionConfig{
+	advRegionConfig := admin.CloudRegionConfig20240805{
 		ProviderName: &providerName,
 		RegionName:   regionCfg.RegionName,
 		Priority:     regionCfg.Priority,
@@ -154,13 +155,16 @@ func expandRegionConfig(regionCfg AdvancedRegionConfig) admin20231115014.CloudRe
 		advRegionConfig.AnalyticsAutoScaling = expandAutoScaling(regionCfg.AnalyticsAutoScaling)
 	}
 	if regionCfg.AnalyticsSpecs != nil {
-		advRegionConfig.AnalyticsSpecs = expandRegionConfigSpec(regionCfg.AnalyticsSpecs)
+		advRegionConfig.AnalyticsSpecs = expandRegionConfigSpec(regionCfg.AnalyticsSpecs, diskSizeGB)
 	}
 	if regionCfg.ElectableSpecs != nil {
 		advRegionConfig.ElectableSpecs = NewHardwareSpec(regionCfg.ElectableSpecs)
+		if advRegionConfig.ElectableSpecs != nil {
+			advRegionConfig.ElectableSpecs.DiskSizeGB = diskSizeGB
+		}
 	}
 	if regionCfg.ReadOnlySpecs != nil {
-		advRegionConfig.ReadOnlySpecs = expandRegionConfigSpec(regionCfg.ReadOnlySpecs)
+		advRegionConfig.ReadOnlySpecs = expandRegionConfigSpec(regionCfg.ReadOnlySpecs, diskSizeGB)
 	}
 	if regionCfg.BackingProviderName != nil {
 		advRegionConfig.BackingProviderName = regionCfg.BackingProviderName
@@ -168,11 +172,11 @@ func expandRegionConfig(regionCfg AdvancedRegionConfig) admin20231115014.CloudRe
 	return advRegionConfig
 }
 
-func NewHardwareSpec(spec *Specs) *admin20231115014.HardwareSpec {
+func NewHardwareSpec(spec *Specs) *admin.HardwareSpec20240805 {
 	if spec == nil {
 		return nil
 	}
-	return &admin20231115014.HardwareSpec{
+	return &admin.HardwareSpec20240805{
 		DiskIOPS:      util.StrPtrToIntPtr(spec.DiskIOPS),
 		EbsVolumeType: spec.EbsVolumeType,
 		InstanceSize:  spec.InstanceSize,
@@ -180,20 +184,21 @@ func NewHardwareSpec(spec *Specs) *admin20231115014.HardwareSpec {
 	}
 }
 
-func expandRegionConfigSpec(spec *Specs) *admin20231115014.DedicatedHardwareSpec {
+func expandRegionConfigSpec(spec *Specs, diskSizeGB *float64) *admin.DedicatedHardwareSpec20240805 {
 	if spec == nil {
 		return nil
 	}
-	return &admin20231115014.DedicatedHardwareSpec{
+	return &admin.DedicatedHardwareSpec20240805{
 		DiskIOPS:      util.StrPtrToIntPtr(spec.DiskIOPS),
+		DiskSizeGB:    diskSizeGB,
 		EbsVolumeType: spec.EbsVolumeType,
 		InstanceSize:  spec.InstanceSize,
 		NodeCount:     spec.NodeCount,
 	}
 }
 
-func expandLabelSlice(labels []Labels) *[]admin20231115014.ComponentLabel {
-	res := make([]admin20231115014.ComponentLabel, len(labels))
+func expandLabelSlice(labels []Labels) *[]admin.ComponentLabel {
+	res := make([]admin.ComponentLabel, len(labels))
 
 	for i := range labels {
 		var key string
@@ -204,7 +209,7 @@ func expandLabelSlice(labels []Labels) *[]admin20231115014.ComponentLabel {
 		if labels[i].Value != nil {
 			value = *labels[i].Value
 		}
-		res[i] = admin20231115014.ComponentLabel{
+		res[i] = admin.ComponentLabel{
 			Key:   &key,
 			Value: &value,
 		}
@@ -212,7 +217,7 @@ func expandLabelSlice(labels []Labels) *[]admin20231115014.ComponentLabel {
 	return &res
 }
 
-func flattenAutoScaling(scaling *admin20231115014.AdvancedAutoScalingSettings) *AdvancedAutoScaling {
+func flattenAutoScaling(scaling *admin.AdvancedAutoScalingSettings) *AdvancedAutoScaling {
 	if scaling == nil {
 		return nil
 	}
@@ -241,23 +246,31 @@ func flattenAutoScaling(scaling *admin20231115014.AdvancedAutoScalingSettings) *
 	return advAutoScaling
 }
 
-func flattenReplicationSpecs(replicationSpecs []admin20231115014.ReplicationSpec) []AdvancedReplicationSpec {
+// GetReplicationSpecsModel groups consecutive identical shards of the same zone into one spec with NumShards,
+// so symmetric clusters are read back as declared. Asymmetric shards are returned as one spec each.
+func GetReplicationSpecsModel(replicationSpecs []admin.ReplicationSpec20240805) []AdvancedReplicationSpec {
 	var rSpecs []AdvancedReplicationSpec
 
 	for ind := range replicationSpecs {
+		regionConfigs := flattenRegionsConfig(replicationSpecs[ind].RegionConfigs)
+		if last := len(rSpecs) - 1; last >= 0 &&
+			util.SafeString(rSpecs[last].ZoneName) == replicationSpecs[ind].GetZoneName() &&
+			reflect.DeepEqual(rSpecs[last].AdvancedRegionConfigs, regionConfigs) {
+			*rSpecs[last].NumShards++
+			continue
+		}
 		rSpec := AdvancedReplicationSpec{
 			ID:                    replicationSpecs[ind].Id,
-			NumShards:             replicationSpecs[ind].NumShards,
+			NumShards:             util.Pointer(1),
 			ZoneName:              replicationSpecs[ind].ZoneName,
-			AdvancedRegionConfigs: flattenRegionsConfig(replicationSpecs[ind].RegionConfigs),
+			AdvancedRegionConfigs: regionConfigs,
 		}
 		rSpecs = append(rSpecs, rSpec)
 	}
-	fmt.Printf("specs: len %d %+v", len(replicationSpecs), rSpecs)
 	return rSpecs
 }
 
-func flattenRegionsConfig(regionConfigs *[]admin20231115014.CloudRegionConfig) []AdvancedRegionConfig {
+func flattenRegionsConfig(regionConfigs *[]admin.CloudRegionConfig20240805) []AdvancedRegionConfig {
 	if regionConfigs == nil {
 		return []AdvancedRegionConfig{}
 	}
@@ -270,7 +283,7 @@ func flattenRegionsConfig(regionConfigs *[]admin20231115014.CloudRegionConfig) [
 	return regionsConfigs
 }
 
-func flattenRegionConfig(regionCfg *admin20231115014.CloudRegionConfig) AdvancedRegionConfig {
+func flattenRegionConfig(regionCfg *admin.CloudRegionConfig20240805) AdvancedRegionConfig {
 	if regionCfg == nil {
 		return AdvancedRegionConfig{}
 	}
@@ -278,6 +291,8 @@ func flattenRegionConfig(regionCfg *admin20231115014.CloudRegionConfig) Advanced
 		AutoScaling:          flattenAutoScaling(regionCfg.AutoScaling),
 		AnalyticsAutoScaling: flattenAutoScaling(regionCfg.AnalyticsAutoScaling),
 		RegionName:           regionCfg.RegionName,
+		ProviderName:         regionCfg.ProviderName,
+		BackingProviderName:  regionCfg.BackingProviderName,
 		Priority:             regionCfg.Priority,
 	}
 	if regionCfg.AnalyticsSpecs != nil {
@@ -294,7 +309,7 @@ func flattenRegionConfig(regionCfg *admin20231115014.CloudRegionConfig) Advanced
 	return advRegConfig
 }
 
-func flattenElectableSpecs(spec *admin20231115014.HardwareSpec) *Specs {
+func flattenElectableSpecs(spec *admin.HardwareSpec20240805) *Specs {
 	if spec == nil {
 		return nil
 	}
@@ -306,7 +321,7 @@ func flattenElectableSpecs(spec *admin20231115014.HardwareSpec) *Specs {
 	}
 }
 
-func flattenRegionConfigSpec(spec *admin20231115014.DedicatedHardwareSpec) *Specs {
+func flattenRegionConfigSpec(spec *admin.DedicatedHardwareSpec20240805) *Specs {
 	if spec == nil {
 		return nil
 	}
@@ -318,7 +333,19 @@ func flattenRegionConfigSpec(spec *admin20231115014.DedicatedHardwareSpec) *Spec
 	}
 }
 
-func flattenBiConnectorConfig(biConnector *admin20231115014.BiConnector) *BiConnector {
+// getDiskSizeGB returns the disk size of the first electable spec, the independent-shard API sets it per hardware spec.
+func getDiskSizeGB(replicationSpecs []admin.ReplicationSpec20240805) *float64 {
+	for i := range replicationSpecs {
+		for _, regionConfig := range replicationSpecs[i].GetRegionConfigs() {
+			if regionConfig.ElectableSpecs != nil && regionConfig.ElectableSpecs.DiskSizeGB != nil {
+				return regionConfig.ElectableSpecs.DiskSizeGB
+			}
+		}
+	}
+	return nil
+}
+
+func flattenBiConnectorConfig(biConnector *admin.BiConnector) *BiConnector {
 	if biConnector == nil {
 		return nil
 	}
@@ -334,7 +361,7 @@ type privateEndpointConnectionStrings struct {
 	SRVShardOptimizedConnectionString []string
 }
 
-func flattenConnectionStrings(clusterConnStrings *admin20231115014.ClusterConnectionStrings) (connStrings *ConnectionStrings) {
+func flattenConnectionStrings(clusterConnStrings *admin.ClusterConnectionStrings) (connStrings *ConnectionStrings) {
 	if clusterConnStrings != nil {
 		privateEndpoints := flattenPrivateEndpoint(clusterConnStrings.PrivateEndpoint)
 		connStrings = &ConnectionStrings{
@@ -350,7 +377,7 @@ func flattenConnectionStrings(clusterConnStrings *admin20231115014.ClusterConnec
 	return
 }
 
-func flattenPrivateEndpoint(pes *[]admin20231115014.ClusterDescriptionConnectionStringsPrivateEndpoint) privateEndpointConnectionStrings {
+func flattenPrivateEndpoint(pes *[]admin.ClusterDescriptionConnectionStringsPrivateEndpoint) privateEndpointConnectionStrings {
 	privateEndpoints := privateEndpointConnectionStrings{
 		PrivateEndpoints:                  make([]string, 0),
 		PrivateEndpointsSrv:               make([]string, 0),
@@ -376,11 +403,9 @@ func flattenPrivateEndpoint(pes *[]admin20231115014.ClusterDescriptionConnection
 	return privateEndpoints
 }
 
-func flattenProcessArgs(p *admin20231115014.ClusterDescriptionProcessArgs, cluster *admin20231115014.AdvancedClusterDescription) *ProcessArgs {
+func flattenProcessArgs(p *admin.ClusterDescriptionProcessArgs20240805, cluster *admin.ClusterDescription20240805) *ProcessArgs {
 	res := &ProcessArgs{
-		DefaultReadConcern:               p.DefaultReadConcern,
 		DefaultWriteConcern:              p.DefaultWriteConcern,
-		FailIndexKeyTooLong:              p.FailIndexKeyTooLong,
 		JavascriptEnabled:                p.JavascriptEnabled,
 		NoTableScan:                      p.NoTableScan,
 		OplogSizeMB:                      p.OplogSizeMB,
@@ -399,7 +424,7 @@ func flattenProcessArgs(p *admin20231115014.ClusterDescriptionProcessArgs, clust
 	return res
 }
 
-func flattenLabels(clusterLabels []admin20231115014.ComponentLabel) []Labels {
+func flattenLabels(clusterLabels []admin.ComponentLabel) []Labels {
 	labels := make([]Labels, len(clusterLabels))
 	for i := range clusterLabels {
 		labels[i] = Labels{
@@ -410,13 +435,9 @@ func flattenLabels(clusterLabels []admin20231115014.ComponentLabel) []Labels {
 	return labels
 }
 
-func expandAdvancedSettings(processArgs ProcessArgs) *admin20231115014.ClusterDescriptionProcessArgs {
-	var args admin20231115014.ClusterDescriptionProcessArgs
+func expandAdvancedSettings(processArgs ProcessArgs) *admin.ClusterDescriptionProcessArgs20240805 {
+	var args admin.ClusterDescriptionProcessArgs20240805
 
-	if processArgs.DefaultReadConcern != nil {
-		args.DefaultReadConcern = processArgs.DefaultReadConcern
-	}
-	args.FailIndexKeyTooLong = processArgs.FailIndexKeyTooLong
 	if processArgs.DefaultWriteConcern != nil {
 		args.DefaultWriteConcern = processArgs.DefaultWriteConcern
 	}
@@ -446,7 +467,7 @@ func expandAdvancedSettings(processArgs ProcessArgs) *admin20231115014.ClusterDe
 	return &args
 }
 
-func flattenTags(clusterTags []admin20231115014.ResourceTag) (tags []Tag) {
+func flattenTags(clusterTags []admin.ResourceTag) (tags []Tag) {
 	for ind := range clusterTags {
 		tags = append(tags, Tag{
 			Key:   &clusterTags[ind].Key,
@@ -456,8 +477,8 @@ func flattenTags(clusterTags []admin20231115014.ResourceTag) (tags []Tag) {
 	return
 }
 
-func expandTags(tags []Tag) (*[]admin20231115014.ResourceTag, error) {
-	clusterTags := []admin20231115014.ResourceTag{}
+func expandTags(tags []Tag) (*[]admin.ResourceTag, error) {
+	clusterTags := []admin.ResourceTag{}
 	for ind := range tags {
 		key := tags[ind].Key
 		value := tags[ind].Value
@@ -467,7 +488,7 @@ func expandTags(tags []Tag) (*[]admin20231115014.ResourceTag, error) {
 		if value == nil {
 			return &clusterTags, fmt.Errorf("tags Value is undefined for %s", *key)
 		}
-		clusterTags = append(clusterTags, admin20231115014.ResourceTag{
+		clusterTags = append(clusterTags, admin.ResourceTag{
 			Key:   *key,
 			Value: *value,
 		})
@@ -475,7 +496,7 @@ func expandTags(tags []Tag) (*[]admin20231115014.ResourceTag, error) {
 	return &clusterTags, nil
 }
 
-func setClusterData(currentModel *Model, cluster *admin20231115014.AdvancedClusterDescription) {
+func setClusterData(currentModel *Model, cluster *admin.ClusterDescription20240805) {
 	if cluster == nil {
 		return
 	}
@@ -498,7 +519,7 @@ func setClusterData(currentModel *Model, cluster *admin20231115014.AdvancedClust
 	// Readonly
 	currentModel.CreatedDate = util.TimePtrToStringPtr(cluster.CreateDate)
 	if currentModel.DiskSizeGB != nil {
-		currentModel.DiskSizeGB = cluster.DiskSizeGB
+		currentModel.DiskSizeGB = getDiskSizeGB(cluster.GetReplicationSpecs())
 	}
 	if currentModel.EncryptionAtRestProvider != nil {
 		currentModel.EncryptionAtRestProvider = cluster.EncryptionAtRestProvider
@@ -515,6 +536,9 @@ func setClusterData(currentModel *Model, cluster *admin20231115014.AdvancedClust
 	if currentModel.Paused != nil {
 		currentModel.Paused = cluster.Paused
 	}
+	if currentModel.PinnedFCV != nil {
+		currentModel.PinnedFCV = GetPinnedFCVModel(cluster)
+	}
 	if currentModel.PitEnabled != nil {
 		currentModel.PitEnabled = cluster.PitEnabled
 	}
@@ -522,7 +546,7 @@ func setClusterData(currentModel *Model, cluster *admin20231115014.AdvancedClust
 		currentModel.RootCertType = cluster.RootCertType
 	}
 	if currentModel.ReplicationSpecs != nil {
-		currentModel.ReplicationSpecs = flattenReplicationSpecs(cluster.GetReplicationSpecs())
+		currentModel.ReplicationSpecs = GetReplicationSpecsModel(cluster.GetReplicationSpecs())
 	}
 	// Readonly
 	if currentModel.GlobalClusterSelfManagedSharding == nil {
@@ -538,12 +562,12 @@ func setClusterData(currentModel *Model, cluster *admin20231115014.AdvancedClust
 	currentModel.Tags = flattenTags(cluster.GetTags())
 }
 
-func setClusterRequest(currentModel *Model) (*admin20231115014.AdvancedClusterDescription, *handler.ProgressEvent) {
-	clusterRequest := &admin20231115014.AdvancedClusterDescription{
+func setClusterRequest(currentModel *Model) (*admin.ClusterDescription20240805, *handler.ProgressEvent) {
+	clusterRequest := &admin.ClusterDescription20240805{
 		Name: currentModel.Name,
 	}
 	if currentModel.ReplicationSpecs != nil {
-		adminRepSpecs := expandReplicationSpecs(currentModel.ReplicationSpecs)
+		adminRepSpecs := NewReplicationSpecs(currentModel.ReplicationSpecs, currentModel.DiskSizeGB)
 		clusterRequest.ReplicationSpecs = &adminRepSpecs
 	}
 
@@ -563,10 +587,6 @@ func setClusterRequest(currentModel *Model) (*admin20231115014.AdvancedClusterDe
 		clusterRequest.BiConnector = expandBiConnector(currentModel.BiConnector)
 	}
 
-	if currentModel.DiskSizeGB != nil {
-		clusterRequest.DiskSizeGB = currentModel.DiskSizeGB
-	}
-
 	if currentModel.GlobalClusterSelfManagedSharding != nil {
 		clusterRequest.GlobalClusterSelfManagedSharding = currentModel.GlobalClusterSelfManagedSharding
 	}
@@ -576,7 +596,7 @@ func setClusterRequest(currentModel *Model) (*admin20231115014.AdvancedClusterDe
 	}
 
 	if currentModel.MongoDBMajorVersion != nil {
-		clusterRequest.MongoDBMajorVersion = admin20231115014.PtrString(formatMongoDBMajorVersion(*currentModel.MongoDBMajorVersion))
+		clusterRequest.MongoDBMajorVersion = admin.PtrString(formatMongoDBMajorVersion(*currentModel.MongoDBMajorVersion))
 	}
 
 	if currentModel.PitEnabled != nil {
@@ -608,8 +628,8 @@ func setClusterRequest(currentModel *Model) (*admin20231115014.AdvancedClusterDe
 	return clusterRequest, nil
 }
 
-func expandClusterAdvancedConfiguration(processArgs ProcessArgs) *admin20231115014.ApiAtlasClusterAdvancedConfiguration {
-	var args admin20231115014.ApiAtlasClusterAdvancedConfiguration
+func expandClusterAdvancedConfiguration(processArgs ProcessArgs) *admin.ApiAtlasClusterAdvancedConfiguration {
+	var args admin.ApiAtlasClusterAdvancedConfiguration
 
 	if processArgs.MinimumEnabledTLSProtocol != nil {
 		args.MinimumEnabledTlsProtocol = processArgs.MinimumEnabledTLSProtocol
@@ -622,50 +642,102 @@ func expandClusterAdvancedConfiguration(processArgs ProcessArgs) *admin202311150
 	return &args
 }
 
-func AddReplicationSpecIDs(src, dest []admin20231115014.ReplicationSpec) *[]admin20231115014.ReplicationSpec {
-	zoneToID := map[string]string{}
-	providerRegionToID := map[string]string{}
+// AddReplicationSpecIDs sets the IDs of the existing shards in the specs without an ID, so Atlas updates the shards
+// instead of replacing them. Shards are matched in order by zone name first, then by provider and region.
+func AddReplicationSpecIDs(src, dest []admin.ReplicationSpec20240805) *[]admin.ReplicationSpec20240805 {
+	zoneToIDs := map[string][]string{}
+	providerRegionToIDs := map[string][]string{}
 	usedIDs := map[string]bool{}
 
+	for _, spec := range dest {
+		if specID := spec.GetId(); specID != "" {
+			usedIDs[specID] = true
+		}
+	}
 	for _, spec := range src {
 		specID := spec.GetId()
 		if specID == "" {
 			continue
 		}
 		if zoneName := spec.GetZoneName(); zoneName != "" {
-			zoneToID[zoneName] = specID
+			zoneToIDs[zoneName] = append(zoneToIDs[zoneName], specID)
 		}
 		if providerRegion := asProviderRegion(spec); providerRegion != "" {
-			providerRegionToID[providerRegion] = spec.GetId()
+			providerRegionToIDs[providerRegion] = append(providerRegionToIDs[providerRegion], specID)
 		}
 	}
 	for i, spec := range dest {
-		specID := spec.GetId()
-		if specID != "" {
+		if spec.GetId() != "" {
 			continue
 		}
-		idZone, foundZone := zoneToID[spec.GetZoneName()]
-		zoneUsed := usedIDs[idZone]
-		if foundZone && !zoneUsed {
+		if idZone := nextUnusedID(zoneToIDs[spec.GetZoneName()], usedIDs); idZone != "" {
 			usedIDs[idZone] = true
 			dest[i].SetId(idZone)
 			continue
 		}
-		idProvider, foundProvider := providerRegionToID[asProviderRegion(spec)]
-		providerUsed := usedIDs[idProvider]
-		if foundProvider && !providerUsed {
+		if idProvider := nextUnusedID(providerRegionToIDs[asProviderRegion(spec)], usedIDs); idProvider != "" {
 			usedIDs[idProvider] = true
 			dest[i].SetId(idProvider)
-			continue
 		}
 	}
 	return &dest
 }
 
-func asProviderRegion(spec admin20231115014.ReplicationSpec) string {
+func nextUnusedID(ids []string, usedIDs map[string]bool) string {
+	for _, id := range ids {
+		if !usedIDs[id] {
+			return id
+		}
+	}
+	return ""
+}
+
+// KeepAutoScaledInstanceSizes sets the current instance sizes of the shards with compute auto-scaling enabled,
+// so an update doesn't revert the instance sizes chosen by Atlas. Specs must have their IDs set.
+func KeepAutoScaledInstanceSizes(src, dest []admin.ReplicationSpec20240805) {
+	currentRegionConfigs := map[string]admin.CloudRegionConfig20240805{}
+	for _, spec := range src {
+		for _, regionConfig := range spec.GetRegionConfigs() {
+			currentRegionConfigs[spec.GetId()+"-"+asRegionConfigKey(regionConfig)] = regionConfig
+		}
+	}
+	for i := range dest {
+		if dest[i].GetId() == "" || dest[i].RegionConfigs == nil {
+			continue
+		}
+		for j := range *dest[i].RegionConfigs {
+			regionConfig := &(*dest[i].RegionConfigs)[j]
+			current, found := currentRegionConfigs[dest[i].GetId()+"-"+asRegionConfigKey(*regionConfig)]
+			if !found {
+				continue
+			}
+			if isComputeAutoScalingEnabled(regionConfig.AutoScaling) {
+				if regionConfig.ElectableSpecs != nil && current.ElectableSpecs != nil {
+					regionConfig.ElectableSpecs.InstanceSize = current.ElectableSpecs.InstanceSize
+				}
+				if regionConfig.ReadOnlySpecs != nil && current.ReadOnlySpecs != nil {
+					regionConfig.ReadOnlySpecs.InstanceSize = current.ReadOnlySpecs.InstanceSize
+				}
+			}
+			if isComputeAutoScalingEnabled(regionConfig.AnalyticsAutoScaling) && regionConfig.AnalyticsSpecs != nil && current.AnalyticsSpecs != nil {
+				regionConfig.AnalyticsSpecs.InstanceSize = current.AnalyticsSpecs.InstanceSize
+			}
+		}
+	}
+}
+
+func isComputeAutoScalingEnabled(scaling *admin.AdvancedAutoScalingSettings) bool {
+	return scaling != nil && scaling.Compute != nil && scaling.Compute.GetEnabled()
+}
+
+func asRegionConfigKey(regionConfig admin.CloudRegionConfig20240805) string {
+	return fmt.Sprintf("%s-%s", regionConfig.GetProviderName(), regionConfig.GetRegionName())
+}
+
+func asProviderRegion(spec admin.ReplicationSpec20240805) string {
 	configs := spec.GetRegionConfigs()
 	if len(configs) == 0 {
 		return ""
 	}
-	return fmt.Sprintf("%s-%s", configs[0].GetProviderName(), configs[0].GetRegionName())
+	return asRegionConfigKey(configs[0])
 }
@@ -17,49 +17,60 @@ package resource_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/cluster/cmd/resource"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 )
 
 func TestAddReplicationSpecIDs(t *testing.T) {
 	testCases := map[string]struct {
-		from        []admin20231115014.ReplicationSpec
-		to          []admin20231115014.ReplicationSpec
+		from        []admin.ReplicationSpec20240805
+		to          []admin.ReplicationSpec20240805
 		expectedIDs []string
 	}{
-		"emptyIsOk": {[]admin20231115014.ReplicationSpec{}, []admin20231115014.ReplicationSpec{}, []string{}},
+		"emptyIsOk": {[]admin.ReplicationSpec20240805{}, []admin.ReplicationSpec20240805{}, []string{}},
 		"zoneNameMatch": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
-			[]admin20231115014.ReplicationSpec{{ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{ZoneName: util.StringPtr("z1")}},
 			[]string{"id1"},
 		},
 		"providerRegionMatch": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
-			[]admin20231115014.ReplicationSpec{{RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
+			[]admin.ReplicationSpec20240805{{RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
 			[]string{"id1"},
 		},
 		"noMatchRegion": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
-			[]admin20231115014.ReplicationSpec{{RegionConfigs: regionConfig("AWS", "US_EAST_2")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
+			[]admin.ReplicationSpec20240805{{RegionConfigs: regionConfig("AWS", "US_EAST_2")}},
 			[]string{""},
 		},
 		"noMatchZone": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
-			[]admin20231115014.ReplicationSpec{{ZoneName: util.StringPtr("z2")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{ZoneName: util.StringPtr("z2")}},
 			[]string{""},
 		},
 		"existingId": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("existing"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("existing"), ZoneName: util.StringPtr("z1")}},
 			[]string{"existing"},
 		},
+		"multipleShardsInZone": {
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}, {Id: util.StringPtr("id2"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{ZoneName: util.StringPtr("z1")}, {ZoneName: util.StringPtr("z1")}, {ZoneName: util.StringPtr("z1")}},
+			[]string{"id1", "id2", ""},
+		},
+		"existingIdNotReused": {
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}, {Id: util.StringPtr("id2"), ZoneName: util.StringPtr("z1")}},
+			[]admin.ReplicationSpec20240805{{ZoneName: util.StringPtr("z1")}, {Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1")}},
+			[]string{"id2", "id1"},
+		},
 		"idMatchedOnlyOnce": {
-			[]admin20231115014.ReplicationSpec{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
-			[]admin20231115014.ReplicationSpec{{ZoneName: util.StringPtr("z1")}, {RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
+			[]admin.ReplicationSpec20240805{{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1"), RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
+			[]admin.ReplicationSpec20240805{{ZoneName: util.StringPtr("z1")}, {RegionConfigs: regionConfig("AWS", "US_EAST_1")}},
 			[]string{"id1", ""},
 		},
 	}
@@ -75,8 +86,8 @@ func TestAddReplicationSpecIDs(t *testing.T) {
 	}
 }
 
-func regionConfig(provider, region string) *[]admin20231115014.CloudRegionConfig {
-	return &[]admin20231115014.CloudRegionConfig{{
+func regionConfig(provider, region string) *[]admin.CloudRegionConfig20240805 {
+	return &[]admin.CloudRegionConfig20240805{{
 		RegionName:   &region,
 		ProviderName: &provider,
 	}}
@@ -117,3 +128,305 @@ func TestNewHardwareSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUpgradeSource(t *testing.T) {
+	testCases := map[string]struct {
+		prevModel    *resource.Model
+		currentModel *resource.Model
+		expected     string
+	}{
+		"nilPrevModel": {
+			currentModel: newSingleRegionModel("AWS", "M10"),
+			expected:     "",
+		},
+		"dedicatedToDedicated": {
+			prevModel:    newSingleRegionModel("AWS", "M10"),
+			currentModel: newSingleRegionModel("AWS", "M20"),
+			expected:     "",
+		},
+		"flexToFlex": {
+			prevModel:    newSingleRegionModel("FLEX", ""),
+			currentModel: newSingleRegionModel("FLEX", ""),
+			expected:     "",
+		},
+		"tenantToFlex": {
+			prevModel:    newSingleRegionModel("TENANT", "M0"),
+			currentModel: newSingleRegionModel("FLEX", ""),
+			expected:     "",
+		},
+		"tenantToDedicated": {
+			prevModel:    newSingleRegionModel("TENANT", "M0"),
+			currentModel: newSingleRegionModel("AWS", "M10"),
+			expected:     "TENANT",
+		},
+		"flexToDedicated": {
+			prevModel:    newSingleRegionModel("FLEX", ""),
+			currentModel: newSingleRegionModel("GCP", "M10"),
+			expected:     "FLEX",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetUpgradeSource(tc.prevModel, tc.currentModel))
+		})
+	}
+}
+
+func TestNewTenantUpgradeReq(t *testing.T) {
+	testCases := map[string]struct {
+		model    *resource.Model
+		expected string
+	}{
+		"noRegions": {
+			model:    &resource.Model{Name: util.StringPtr("cluster")},
+			expected: `null`,
+		},
+		"dedicatedRegion": {
+			model:    newSingleRegionModel("AWS", "M10"),
+			expected: `{"name":"cluster","clusterType":"REPLICASET","providerSettings":{"providerName":"AWS","instanceSizeName":"M10","regionName":"US_EAST_1"}}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reqJSON, err := json.Marshal(resource.NewTenantUpgradeReq(tc.model))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(reqJSON))
+		})
+	}
+}
+
+func TestNewFlexUpgradeReq(t *testing.T) {
+	testCases := map[string]struct {
+		model    *resource.Model
+		expected string
+	}{
+		"noRegions": {
+			model:    &resource.Model{Name: util.StringPtr("cluster")},
+			expected: `null`,
+		},
+		"dedicatedRegion": {
+			model: newSingleRegionModel("AWS", "M10"),
+			expected: `{"name":"cluster","clusterType":"REPLICASET","replicationSpecs":[{"zoneName":"Zone 1","regionConfigs":[` +
+				`{"providerName":"AWS","regionName":"US_EAST_1","priority":7,"electableSpecs":{"instanceSize":"M10","nodeCount":3}}]}]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reqJSON, err := json.Marshal(resource.NewFlexUpgradeReq(tc.model))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(reqJSON))
+		})
+	}
+}
+
+func newSingleRegionModel(provider, instanceSize string) *resource.Model {
+	regionConfig := resource.AdvancedRegionConfig{
+		ProviderName: util.StringPtr(provider),
+		RegionName:   util.StringPtr("US_EAST_1"),
+		Priority:     util.IntPtr(7),
+	}
+	if instanceSize != "" {
+		regionConfig.ElectableSpecs = &resource.Specs{
+			InstanceSize: util.StringPtr(instanceSize),
+			NodeCount:    util.IntPtr(3),
+		}
+	}
+	return &resource.Model{
+		Name:        util.StringPtr("cluster"),
+		ClusterType: util.StringPtr("REPLICASET"),
+		ReplicationSpecs: []resource.AdvancedReplicationSpec{{
+			ZoneName:              util.StringPtr("Zone 1"),
+			AdvancedRegionConfigs: []resource.AdvancedRegionConfig{regionConfig},
+		}},
+	}
+}
+
+func TestNewReplicationSpecs(t *testing.T) {
+	testCases := map[string]struct {
+		diskSizeGB *float64
+		expected   string
+		specs      []resource.AdvancedReplicationSpec
+	}{
+		"empty": {
+			specs:    []resource.AdvancedReplicationSpec{},
+			expected: `[]`,
+		},
+		"symmetricShards": {
+			specs: []resource.AdvancedReplicationSpec{{
+				ID:                    util.StringPtr("id1"),
+				NumShards:             util.IntPtr(2),
+				ZoneName:              util.StringPtr("z1"),
+				AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")},
+			}},
+			diskSizeGB: util.Pointer(40.0),
+			expected: `[
+				{"id":"id1","zoneName":"z1","regionConfigs":[{"providerName":"AWS","regionName":"US_EAST_1","priority":7,"electableSpecs":{"instanceSize":"M30","nodeCount":3,"diskSizeGB":40}}]},
+				{"zoneName":"z1","regionConfigs":[{"providerName":"AWS","regionName":"US_EAST_1","priority":7,"electableSpecs":{"instanceSize":"M30","nodeCount":3,"diskSizeGB":40}}]}
+			]`,
+		},
+		"asymmetricShards": {
+			specs: []resource.AdvancedReplicationSpec{
+				{AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")}},
+				{AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M40")}},
+			},
+			expected: `[
+				{"regionConfigs":[{"providerName":"AWS","regionName":"US_EAST_1","priority":7,"electableSpecs":{"instanceSize":"M30","nodeCount":3}}]},
+				{"regionConfigs":[{"providerName":"AWS","regionName":"US_EAST_1","priority":7,"electableSpecs":{"instanceSize":"M40","nodeCount":3}}]}
+			]`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			specsJSON, err := json.Marshal(resource.NewReplicationSpecs(tc.specs, tc.diskSizeGB))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(specsJSON))
+		})
+	}
+}
+
+func TestGetReplicationSpecsModel(t *testing.T) {
+	testCases := map[string]struct {
+		specs    []admin.ReplicationSpec20240805
+		expected []resource.AdvancedReplicationSpec
+	}{
+		"empty": {
+			specs:    []admin.ReplicationSpec20240805{},
+			expected: nil,
+		},
+		"symmetricShardsAreGrouped": {
+			specs: []admin.ReplicationSpec20240805{
+				{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1"), RegionConfigs: cloudRegionConfig("M30")},
+				{Id: util.StringPtr("id2"), ZoneName: util.StringPtr("z1"), RegionConfigs: cloudRegionConfig("M30")},
+			},
+			expected: []resource.AdvancedReplicationSpec{
+				{ID: util.StringPtr("id1"), NumShards: util.IntPtr(2), ZoneName: util.StringPtr("z1"), AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")}},
+			},
+		},
+		"asymmetricShards": {
+			specs: []admin.ReplicationSpec20240805{
+				{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1"), RegionConfigs: cloudRegionConfig("M30")},
+				{Id: util.StringPtr("id2"), ZoneName: util.StringPtr("z1"), RegionConfigs: cloudRegionConfig("M40")},
+			},
+			expected: []resource.AdvancedReplicationSpec{
+				{ID: util.StringPtr("id1"), NumShards: util.IntPtr(1), ZoneName: util.StringPtr("z1"), AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")}},
+				{ID: util.StringPtr("id2"), NumShards: util.IntPtr(1), ZoneName: util.StringPtr("z1"), AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M40")}},
+			},
+		},
+		"differentZones": {
+			specs: []admin.ReplicationSpec20240805{
+				{Id: util.StringPtr("id1"), ZoneName: util.StringPtr("z1"), RegionConfigs: cloudRegionConfig("M30")},
+				{Id: util.StringPtr("id2"), ZoneName: util.StringPtr("z2"), RegionConfigs: cloudRegionConfig("M30")},
+			},
+			expected: []resource.AdvancedReplicationSpec{
+				{ID: util.StringPtr("id1"), NumShards: util.IntPtr(1), ZoneName: util.StringPtr("z1"), AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")}},
+				{ID: util.StringPtr("id2"), NumShards: util.IntPtr(1), ZoneName: util.StringPtr("z2"), AdvancedRegionConfigs: []resource.AdvancedRegionConfig{advancedRegionConfig("M30")}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetReplicationSpecsModel(tc.specs))
+		})
+	}
+}
+
+func TestKeepAutoScaledInstanceSizes(t *testing.T) {
+	autoScaling := &admin.AdvancedAutoScalingSettings{Compute: &admin.AdvancedComputeAutoScaling{Enabled: util.Pointer(true)}}
+	current := []admin.ReplicationSpec20240805{
+		{Id: util.StringPtr("id1"), RegionConfigs: cloudRegionConfig("M40")},
+		{Id: util.StringPtr("id2"), RegionConfigs: cloudRegionConfig("M50")},
+	}
+	desired := []admin.ReplicationSpec20240805{
+		{Id: util.StringPtr("id1"), RegionConfigs: cloudRegionConfig("M30")},
+		{Id: util.StringPtr("id2"), RegionConfigs: cloudRegionConfig("M30")},
+		{RegionConfigs: cloudRegionConfig("M30")},
+	}
+	(*desired[0].RegionConfigs)[0].AutoScaling = autoScaling
+	(*desired[2].RegionConfigs)[0].AutoScaling = autoScaling
+
+	resource.KeepAutoScaledInstanceSizes(current, desired)
+
+	sizes := []string{}
+	for _, spec := range desired {
+		sizes = append(sizes, spec.GetRegionConfigs()[0].ElectableSpecs.GetInstanceSize())
+	}
+	assert.Equal(t, []string{"M40", "M30", "M30"}, sizes)
+}
+
+func advancedRegionConfig(instanceSize string) resource.AdvancedRegionConfig {
+	return resource.AdvancedRegionConfig{
+		ProviderName: util.StringPtr("AWS"),
+		RegionName:   util.StringPtr("US_EAST_1"),
+		Priority:     util.IntPtr(7),
+		ElectableSpecs: &resource.Specs{
+			InstanceSize: util.StringPtr(instanceSize),
+			NodeCount:    util.IntPtr(3),
+		},
+	}
+}
+
+func cloudRegionConfig(instanceSize string) *[]admin.CloudRegionConfig20240805 {
+	return &[]admin.CloudRegionConfig20240805{{
+		ProviderName: util.StringPtr("AWS"),
+		RegionName:   util.StringPtr("US_EAST_1"),
+		Priority:     util.IntPtr(7),
+		ElectableSpecs: &admin.HardwareSpec20240805{
+			InstanceSize: util.StringPtr(instanceSize),
+			NodeCount:    util.IntPtr(3),
+		},
+	}}
+}
+
+func TestValidateMongoDBMajorVersionChange(t *testing.T) {
+	testCases := map[string]struct {
+		currentVersion string
+		targetVersion  string
+		fcvPinned      bool
+		expectedError  bool
+	}{
+		"sameVersion":             {currentVersion: "7.0", targetVersion: "7.0"},
+		"noTargetVersion":         {currentVersion: "7.0"},
+		"upgradeOneVersion":       {currentVersion: "7.0", targetVersion: "8.0"},
+		"upgradeFrom44":           {currentVersion: "4.4", targetVersion: "5.0", expectedError: true},
+		"upgradeTwoVersions":      {currentVersion: "6.0", targetVersion: "8.0", expectedError: true},
+		"downgradeWithPinnedFCV":  {currentVersion: "8.0", targetVersion: "7.0", fcvPinned: true},
+		"downgradeWithoutPinning": {currentVersion: "8.0", targetVersion: "7.0", expectedError: true},
+		"downgradeTwoVersions":    {currentVersion: "8.0", targetVersion: "6.0", fcvPinned: true, expectedError: true},
+		"unknownVersion":          {currentVersion: "7.0", targetVersion: "7.5", expectedError: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := resource.ValidateMongoDBMajorVersionChange(tc.currentVersion, tc.targetVersion, tc.fcvPinned)
+			if tc.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetPinnedFCVModel(t *testing.T) {
+	expirationDate := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		cluster  *admin.ClusterDescription20240805
+		expected *resource.PinnedFCV
+	}{
+		"nilCluster": {},
+		"notPinned": {
+			cluster: &admin.ClusterDescription20240805{FeatureCompatibilityVersion: util.StringPtr("8.0")},
+		},
+		"pinned": {
+			cluster: &admin.ClusterDescription20240805{
+				FeatureCompatibilityVersion:               util.StringPtr("7.0"),
+				FeatureCompatibilityVersionExpirationDate: &expirationDate,
+			},
+			expected: &resource.PinnedFCV{ExpirationDate: util.StringPtr("2025-11-01T10:00:00Z"), Version: util.StringPtr("7.0")},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetPinnedFCVModel(tc.cluster))
+		})
+	}
+}
@@ -21,9 +21,11 @@ type Model struct {
 	MongoDBVersion                   *string                   `json:",omitempty"`
 	Name                             *string                   `json:",omitempty"`
 	Paused                           *bool                     `json:",omitempty"`
+	PinnedFCV                        *PinnedFCV                `json:",omitempty"`
 	PitEnabled                       *bool                     `json:",omitempty"`
 	ReplicationSpecs                 []AdvancedReplicationSpec `json:",omitempty"`
 	RootCertType                     *string                   `json:",omitempty"`
+	SnapshotBeforeVersionUpgrade     *bool                     `json:",omitempty"`
 	StateName                        *string                   `json:",omitempty"`
 	VersionReleaseSystem             *string                   `json:",omitempty"`
 	TerminationProtectionEnabled     *bool                     `json:",omitempty"`
@@ -123,3 +125,9 @@ type Tag struct {
 	Key   *string `json:",omitempty"`
 	Value *string `json:",omitempty"`
 }
+
+// PinnedFCV is autogenerated from the json schema
+type PinnedFCV struct {
+	ExpirationDate *string `json:",omitempty"`
+	Version        *string `json:",omitempty"`
+}
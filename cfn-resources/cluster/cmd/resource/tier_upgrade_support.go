@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+const (
+	tenantProvider        = "TENANT"
+	flexInClusterAPIError = "CANNOT_USE_FLEX_CLUSTER_IN_CLUSTER_API"
+)
+
+var upgradeCallbackContext = map[string]any{"callbackUpgrade": true}
+
+func isUpgradeCallback(req *handler.Request) bool {
+	_, found := req.CallbackContext["callbackUpgrade"]
+	return found
+}
+
+// GetUpgradeSource returns the provider (FLEX or TENANT) the cluster is upgraded from when the update
+// moves a shared-tier or flex cluster to a dedicated provider, or an empty string otherwise.
+func GetUpgradeSource(prevModel, currentModel *Model) string {
+	prevProvider := firstRegionProvider(prevModel)
+	if prevProvider != flexProvider && prevProvider != tenantProvider {
+		return ""
+	}
+	currentProvider := firstRegionProvider(currentModel)
+	if currentProvider == "" || currentProvider == flexProvider || currentProvider == tenantProvider {
+		return ""
+	}
+	return prevProvider
+}
+
+// NewTenantUpgradeReq builds the request to upgrade a shared-tier cluster using the first region config of the first replication spec.
+func NewTenantUpgradeReq(model *Model) *admin.LegacyAtlasTenantClusterUpgradeRequest {
+	regionCfg := firstRegionConfig(model)
+	if regionCfg == nil {
+		return nil
+	}
+	providerSettings := &admin.ClusterProviderSettings{
+		ProviderName: util.SafeString(regionCfg.ProviderName),
+		RegionName:   regionCfg.RegionName,
+	}
+	if regionCfg.ElectableSpecs != nil {
+		providerSettings.InstanceSizeName = regionCfg.ElectableSpecs.InstanceSize
+	}
+	return &admin.LegacyAtlasTenantClusterUpgradeRequest{
+		Name:             util.SafeString(model.Name),
+		ClusterType:      model.ClusterType,
+		ProviderSettings: providerSettings,
+	}
+}
+
+// NewFlexUpgradeReq builds the request to upgrade a flex cluster using the first region config of the first replication spec.
+func NewFlexUpgradeReq(model *Model) *admin.AtlasTenantClusterUpgradeRequest20240805 {
+	regionCfg := firstRegionConfig(model)
+	if regionCfg == nil {
+		return nil
+	}
+	cloudRegionConfig := admin.CloudRegionConfig20240805{
+		ProviderName: regionCfg.ProviderName,
+		RegionName:   regionCfg.RegionName,
+		Priority:     regionCfg.Priority,
+	}
+	if regionCfg.ElectableSpecs != nil {
+		cloudRegionConfig.ElectableSpecs = &admin.HardwareSpec20240805{
+			InstanceSize: regionCfg.ElectableSpecs.InstanceSize,
+			NodeCount:    regionCfg.ElectableSpecs.NodeCount,
+		}
+	}
+	return &admin.AtlasTenantClusterUpgradeRequest20240805{
+		Name:        util.SafeString(model.Name),
+		ClusterType: model.ClusterType,
+		ReplicationSpecs: &[]admin.ReplicationSpec20240805{
+			{
+				ZoneName:      model.ReplicationSpecs[0].ZoneName,
+				RegionConfigs: &[]admin.CloudRegionConfig20240805{cloudRegionConfig},
+			},
+		},
+	}
+}
+
+// upgradeCluster starts the in-place upgrade of a shared-tier or flex cluster to a dedicated one.
+// The rest of the model is applied by a regular update once the upgrade has finished.
+func upgradeCluster(client *util.MongoDBClient, currentModel *Model, source string) handler.ProgressEvent {
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	if source == flexProvider {
+		cluster, resp, err := client.AtlasSDK.FlexClustersApi.TenantUpgrade(ctx, projectID, NewFlexUpgradeReq(currentModel)).Execute()
+		if pe := util.HandleClusterError(err, resp); pe != nil {
+			return *pe
+		}
+		currentModel.StateName = cluster.StateName
+	} else {
+		cluster, resp, err := client.AtlasSDK.ClustersApi.UpgradeTenantUpgrade(ctx, projectID, NewTenantUpgradeReq(currentModel)).Execute()
+		if pe := util.HandleClusterError(err, resp); pe != nil {
+			return *pe
+		}
+		currentModel.StateName = cluster.StateName
+	}
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              fmt.Sprintf("Upgrade Cluster from %s %s", source, util.SafeString(currentModel.StateName)),
+		ResourceModel:        currentModel,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext:      upgradeCallbackContext,
+	}
+}
+
+// upgradeClusterCallback waits for the upgraded cluster to be IDLE and then applies the rest of the model.
+func upgradeClusterCallback(client *util.MongoDBClient, currentModel *Model) (handler.ProgressEvent, error) {
+	cluster, resp, err := client.AtlasSDK.ClustersApi.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.Name).Execute()
+	if err != nil && strings.Contains(err.Error(), flexInClusterAPIError) {
+		return upgradePendingEvent(currentModel), nil // flex cluster not yet migrated
+	}
+	if pe := util.HandleClusterError(err, resp); pe != nil {
+		return *pe, nil
+	}
+	if cluster.GetStateName() != constants.IdleState {
+		return upgradePendingEvent(currentModel), nil
+	}
+	return applyClusterUpdate(client, currentModel, cluster)
+}
+
+func upgradePendingEvent(currentModel *Model) handler.ProgressEvent {
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              constants.Pending,
+		ResourceModel:        currentModel,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext:      upgradeCallbackContext,
+	}
+}
+
+func firstRegionConfig(model *Model) *AdvancedRegionConfig {
+	if model == nil || len(model.ReplicationSpecs) == 0 || len(model.ReplicationSpecs[0].AdvancedRegionConfigs) == 0 {
+		return nil
+	}
+	return &model.ReplicationSpecs[0].AdvancedRegionConfigs[0]
+}
+
+func firstRegionProvider(model *Model) string {
+	if regionCfg := firstRegionConfig(model); regionCfg != nil {
+		return util.SafeString(regionCfg.ProviderName)
+	}
+	return ""
+}
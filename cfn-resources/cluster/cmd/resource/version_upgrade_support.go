@@ -0,0 +1,225 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+)
+
+const (
+	continuousReleaseSystem    = "CONTINUOUS"
+	replicaSetClusterType      = "REPLICASET"
+	snapshotCompleted          = "completed"
+	snapshotFailed             = "failed"
+	snapshotRetentionInDays    = 7
+	versionSnapshotIDCtxKey    = "callbackVersionSnapshot"
+	versionUpgradeSnapshotDesc = "Snapshot taken by CloudFormation before the MongoDB major version change"
+)
+
+// mongoDBMajorVersions lists the major versions in upgrade order, Atlas only allows moving between adjacent ones.
+var mongoDBMajorVersions = []string{"5.0", "6.0", "7.0", "8.0"}
+
+func getVersionSnapshotID(req *handler.Request) (string, bool) {
+	snapshotID, found := req.CallbackContext[versionSnapshotIDCtxKey].(string)
+	return snapshotID, found
+}
+
+// ValidateMongoDBMajorVersionChange checks that the cluster moves at most one major version at a time,
+// and that a downgrade only happens when the feature compatibility version was pinned before the upgrade.
+func ValidateMongoDBMajorVersionChange(currentVersion, targetVersion string, fcvPinned bool) error {
+	if currentVersion == "" || targetVersion == "" || currentVersion == targetVersion {
+		return nil
+	}
+	currentIdx := slices.Index(mongoDBMajorVersions, currentVersion)
+	targetIdx := slices.Index(mongoDBMajorVersions, targetVersion)
+	if currentIdx < 0 || targetIdx < 0 {
+		return fmt.Errorf("unsupported MongoDB major version change from %s to %s", currentVersion, targetVersion)
+	}
+	switch targetIdx - currentIdx {
+	case 1:
+		return nil
+	case -1:
+		if !fcvPinned {
+			return fmt.Errorf("MongoDB major version can only be downgraded from %s to %s while the feature compatibility version is pinned, set PinnedFCV before upgrading", currentVersion, targetVersion)
+		}
+		return nil
+	default:
+		return fmt.Errorf("MongoDB major version can only be changed one version at a time, %s can't be changed to %s", currentVersion, targetVersion)
+	}
+}
+
+// GetPinnedFCVModel returns the pinned feature compatibility version of the cluster, or nil if it isn't pinned.
+func GetPinnedFCVModel(cluster *admin.ClusterDescription20240805) *PinnedFCV {
+	if cluster == nil || cluster.FeatureCompatibilityVersionExpirationDate == nil {
+		return nil
+	}
+	return &PinnedFCV{
+		ExpirationDate: util.TimePtrToStringPtr(cluster.FeatureCompatibilityVersionExpirationDate),
+		Version:        cluster.FeatureCompatibilityVersion,
+	}
+}
+
+// getTargetMongoDBMajorVersion returns the major version requested by the model when it differs from the cluster one.
+func getTargetMongoDBMajorVersion(currentModel *Model, cluster *admin.ClusterDescription20240805) string {
+	if currentModel.MongoDBMajorVersion == nil || util.SafeString(currentModel.VersionReleaseSystem) == continuousReleaseSystem {
+		return ""
+	}
+	targetVersion := formatMongoDBMajorVersion(*currentModel.MongoDBMajorVersion)
+	if cluster == nil || targetVersion == cluster.GetMongoDBMajorVersion() {
+		return ""
+	}
+	return targetVersion
+}
+
+// prepareVersionUpgrade validates a major version change, pins or unpins the FCV, and takes the pre-upgrade snapshot if requested.
+// It returns a non-nil event when the update must stop or wait for the snapshot before being applied.
+func prepareVersionUpgrade(client *util.MongoDBClient, prevModel, currentModel *Model, cluster *admin.ClusterDescription20240805) *handler.ProgressEvent {
+	targetVersion := getTargetMongoDBMajorVersion(currentModel, cluster)
+	if targetVersion != "" {
+		fcvPinned := cluster.FeatureCompatibilityVersionExpirationDate != nil
+		if err := ValidateMongoDBMajorVersionChange(cluster.GetMongoDBMajorVersion(), targetVersion, fcvPinned); err != nil {
+			pe := progressevent.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest))
+			return &pe
+		}
+	}
+	if pe := updatePinnedFCV(client, prevModel, currentModel, cluster); pe != nil {
+		return pe
+	}
+	if targetVersion != "" && aws.ToBool(currentModel.SnapshotBeforeVersionUpgrade) {
+		return takeVersionUpgradeSnapshot(client, currentModel)
+	}
+	return nil
+}
+
+// updatePinnedFCV pins the FCV when PinnedFCV is set and unpins it when PinnedFCV is removed from the template.
+func updatePinnedFCV(client *util.MongoDBClient, prevModel, currentModel *Model, cluster *admin.ClusterDescription20240805) *handler.ProgressEvent {
+	ctx := context.Background()
+	isPinned := cluster.FeatureCompatibilityVersionExpirationDate != nil
+	if currentModel.PinnedFCV == nil {
+		if !isPinned || prevModel == nil || prevModel.PinnedFCV == nil {
+			return nil
+		}
+		resp, err := client.AtlasSDK.ClustersApi.UnpinFeatureCompatibilityVersion(ctx, *currentModel.ProjectId, *currentModel.Name).Execute()
+		return util.HandleClusterError(err, resp)
+	}
+
+	pinFCV := &admin.PinFCV{}
+	if util.IsStringPresent(currentModel.PinnedFCV.ExpirationDate) {
+		expirationDate, err := util.StringToTime(*currentModel.PinnedFCV.ExpirationDate)
+		if err != nil {
+			pe := progressevent.GetFailedEventByCode(fmt.Sprintf("invalid PinnedFCV ExpirationDate: %s", err.Error()), string(types.HandlerErrorCodeInvalidRequest))
+			return &pe
+		}
+		if isPinned && expirationDate.Equal(*cluster.FeatureCompatibilityVersionExpirationDate) {
+			return nil
+		}
+		pinFCV.ExpirationDate = &expirationDate
+	} else if isPinned {
+		return nil
+	}
+	resp, err := client.AtlasSDK.ClustersApi.PinFeatureCompatibilityVersion(ctx, *currentModel.ProjectId, *currentModel.Name, pinFCV).Execute()
+	return util.HandleClusterError(err, resp)
+}
+
+func takeVersionUpgradeSnapshot(client *util.MongoDBClient, currentModel *Model) *handler.ProgressEvent {
+	snapshotReq := &admin.DiskBackupOnDemandSnapshotRequest{
+		Description:     admin.PtrString(versionUpgradeSnapshotDesc),
+		RetentionInDays: admin.PtrInt(snapshotRetentionInDays),
+	}
+	snapshot, resp, err := client.AtlasSDK.CloudBackupsApi.TakeSnapshots(context.Background(), *currentModel.ProjectId, *currentModel.Name, snapshotReq).Execute()
+	if pe := util.HandleClusterError(err, resp); pe != nil {
+		return pe
+	}
+	return &handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              fmt.Sprintf("Taking snapshot %s before the MongoDB major version change", snapshot.GetId()),
+		ResourceModel:        currentModel,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext:      map[string]any{versionSnapshotIDCtxKey: snapshot.GetId()},
+	}
+}
+
+// versionUpgradeSnapshotCallback waits for the pre-upgrade snapshot to complete and then applies the update.
+func versionUpgradeSnapshotCallback(client *util.MongoDBClient, currentModel *Model, snapshotID string) (handler.ProgressEvent, error) {
+	status, pe := getSnapshotStatus(client, currentModel, snapshotID)
+	if pe != nil {
+		return *pe, nil
+	}
+	switch status {
+	case snapshotCompleted:
+		cluster, resp, err := client.AtlasSDK.ClustersApi.GetCluster(context.Background(), *currentModel.ProjectId, *currentModel.Name).Execute()
+		if pe := util.HandleClusterError(err, resp); pe != nil {
+			return *pe, nil
+		}
+		return applyClusterUpdate(client, currentModel, cluster)
+	case snapshotFailed:
+		return progressevent.GetFailedEventByCode(fmt.Sprintf("snapshot %s taken before the MongoDB major version change failed, the cluster was not updated", snapshotID),
+			string(types.HandlerErrorCodeGeneralServiceException)), nil
+	default:
+		return handler.ProgressEvent{
+			OperationStatus:      handler.InProgress,
+			Message:              constants.Pending,
+			ResourceModel:        currentModel,
+			CallbackDelaySeconds: callBackSeconds,
+			CallbackContext:      map[string]any{versionSnapshotIDCtxKey: snapshotID},
+		}, nil
+	}
+}
+
+func getSnapshotStatus(client *util.MongoDBClient, currentModel *Model, snapshotID string) (string, *handler.ProgressEvent) {
+	ctx := context.Background()
+	if currentModel.ClusterType == nil || *currentModel.ClusterType == replicaSetClusterType {
+		snapshot, resp, err := client.AtlasSDK.CloudBackupsApi.GetClusterBackupSnapshot(ctx, *currentModel.ProjectId, *currentModel.Name, snapshotID).Execute()
+		if pe := util.HandleClusterError(err, resp); pe != nil {
+			return "", pe
+		}
+		return snapshot.GetStatus(), nil
+	}
+	snapshot, resp, err := client.AtlasSDK.CloudBackupsApi.GetBackupShardedCluster(ctx, *currentModel.ProjectId, *currentModel.Name, snapshotID).Execute()
+	if pe := util.HandleClusterError(err, resp); pe != nil {
+		return "", pe
+	}
+	return snapshot.GetStatus(), nil
+}
+
+// handleVersionChangeError makes an update rejected by Atlas explicit about the requested major version change.
+func handleVersionChangeError(pe *handler.ProgressEvent, currentModel *Model, cluster *admin.ClusterDescription20240805) *handler.ProgressEvent {
+	if targetVersion := getTargetMongoDBMajorVersion(currentModel, cluster); targetVersion != "" {
+		pe.Message = fmt.Sprintf("Atlas rejected the MongoDB major version change from %s to %s: %s", cluster.GetMongoDBMajorVersion(), targetVersion, pe.Message)
+	}
+	return pe
+}
+
+// validateMongoDBMajorVersion fails the update when the IDLE cluster doesn't report the requested major version.
+func validateMongoDBMajorVersion(currentModel *Model, cluster *admin.ClusterDescription20240805) *handler.ProgressEvent {
+	if targetVersion := getTargetMongoDBMajorVersion(currentModel, cluster); targetVersion != "" {
+		pe := progressevent.GetFailedEventByCode(fmt.Sprintf("cluster %s reports MongoDB major version %s instead of %s after the update",
+			cluster.GetName(), cluster.GetMongoDBMajorVersion(), targetVersion), string(types.HandlerErrorCodeGeneralServiceException))
+		return &pe
+	}
+	return nil
+}
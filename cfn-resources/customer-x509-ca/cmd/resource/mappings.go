@@ -0,0 +1,131 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const pemCertificateType = "CERTIFICATE"
+
+// ParseCertificates decodes every certificate of a PEM chain.
+func ParseCertificates(cas string) ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0)
+	rest := []byte(cas)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != pemCertificateType {
+			return nil, fmt.Errorf("unexpected PEM block of type %s, only %s blocks are allowed", block.Type, pemCertificateType)
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d can't be parsed: %w", len(certificates)+1, err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("the CA chain contains data that is not PEM encoded")
+	}
+	if len(certificates) == 0 {
+		return nil, errors.New("the CA chain must contain at least one PEM encoded certificate")
+	}
+	return certificates, nil
+}
+
+// ValidateCAChain checks that the PEM chain can be parsed and that all its certificates are CA certificates valid at now,
+// so that mistakes are reported before Atlas rejects the chain or database users can't authenticate.
+func ValidateCAChain(cas string, now time.Time) error {
+	certificates, err := ParseCertificates(cas)
+	if err != nil {
+		return err
+	}
+	for _, certificate := range certificates {
+		subject := certificate.Subject.String()
+		if !certificate.BasicConstraintsValid || !certificate.IsCA {
+			return fmt.Errorf("certificate %s is not a CA certificate", subject)
+		}
+		if now.Before(certificate.NotBefore) {
+			return fmt.Errorf("certificate %s is not valid before %s", subject, util.TimeToString(certificate.NotBefore))
+		}
+		if now.After(certificate.NotAfter) {
+			return fmt.Errorf("certificate %s expired on %s", subject, util.TimeToString(certificate.NotAfter))
+		}
+	}
+	return nil
+}
+
+// IsEnabled reports whether customer-managed X.509 authentication is configured for the project.
+func IsEnabled(userSecurity *admin.UserSecurity) bool {
+	return userSecurity != nil && userSecurity.CustomerX509 != nil && util.IsStringPresent(userSecurity.CustomerX509.Cas)
+}
+
+func NewUserSecurityReq(model *Model) *admin.UserSecurity {
+	if model == nil {
+		return nil
+	}
+	return &admin.UserSecurity{
+		CustomerX509: &admin.DBUserTLSX509Settings{Cas: model.Cas},
+	}
+}
+
+// GetCustomerX509CAModel returns the model with the CA chain configured in Atlas and the details of its certificates.
+func GetCustomerX509CAModel(userSecurity *admin.UserSecurity, currentModel *Model) (*Model, error) {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+
+	if !IsEnabled(userSecurity) {
+		return model, nil
+	}
+
+	model.Cas = userSecurity.CustomerX509.Cas
+	certificates, err := ParseCertificates(*model.Cas)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Certificates = make([]Certificate, 0, len(certificates))
+	var earliestExpiration time.Time
+	for i, certificate := range certificates {
+		model.Certificates = append(model.Certificates, Certificate{
+			Subject:      util.Pointer(certificate.Subject.String()),
+			Issuer:       util.Pointer(certificate.Issuer.String()),
+			SerialNumber: util.Pointer(certificate.SerialNumber.String()),
+			NotBefore:    util.Pointer(util.TimeToString(certificate.NotBefore)),
+			NotAfter:     util.Pointer(util.TimeToString(certificate.NotAfter)),
+		})
+		if i == 0 || certificate.NotAfter.Before(earliestExpiration) {
+			earliestExpiration = certificate.NotAfter
+		}
+	}
+	model.EarliestExpiration = util.Pointer(util.TimeToString(earliestExpiration))
+	return model, nil
+}
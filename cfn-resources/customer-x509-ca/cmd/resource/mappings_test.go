@@ -0,0 +1,135 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/customer-x509-ca/cmd/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func newCertificatePEM(t *testing.T, commonName string, isCA bool, notBefore, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateCAChain(t *testing.T) {
+	validCA := newCertificatePEM(t, "valid", true, now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0))
+	tests := []struct {
+		name          string
+		input         string
+		expectedError bool
+	}{
+		{
+			name:          "Valid chain",
+			input:         validCA + newCertificatePEM(t, "other", true, now.AddDate(-1, 0, 0), now.AddDate(2, 0, 0)),
+			expectedError: false,
+		},
+		{
+			name:          "Empty",
+			input:         "",
+			expectedError: true,
+		},
+		{
+			name:          "Not PEM",
+			input:         "not a certificate",
+			expectedError: true,
+		},
+		{
+			name:          "Trailing data",
+			input:         validCA + "garbage",
+			expectedError: true,
+		},
+		{
+			name:          "Private key block",
+			input:         validCA + string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})),
+			expectedError: true,
+		},
+		{
+			name:          "Not a CA",
+			input:         newCertificatePEM(t, "leaf", false, now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0)),
+			expectedError: true,
+		},
+		{
+			name:          "Expired",
+			input:         newCertificatePEM(t, "expired", true, now.AddDate(-2, 0, 0), now.AddDate(0, 0, -1)),
+			expectedError: true,
+		},
+		{
+			name:          "Not yet valid",
+			input:         newCertificatePEM(t, "future", true, now.AddDate(0, 0, 1), now.AddDate(1, 0, 0)),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, resource.ValidateCAChain(tt.input, now) != nil)
+		})
+	}
+}
+
+func TestGetCustomerX509CAModel(t *testing.T) {
+	first := newCertificatePEM(t, "first", true, now.AddDate(-1, 0, 0), now.AddDate(2, 0, 0))
+	second := newCertificatePEM(t, "second", true, now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0))
+	userSecurity := &admin.UserSecurity{
+		CustomerX509: &admin.DBUserTLSX509Settings{Cas: ptr.String(first + second)},
+	}
+
+	model, err := resource.GetCustomerX509CAModel(userSecurity, &resource.Model{ProjectId: ptr.String("projectId")})
+	require.NoError(t, err)
+	assert.Equal(t, "projectId", *model.ProjectId)
+	assert.Equal(t, first+second, *model.Cas)
+	require.Len(t, model.Certificates, 2)
+	assert.Equal(t, "CN=first", *model.Certificates[0].Subject)
+	assert.Equal(t, "CN=first", *model.Certificates[0].Issuer)
+	assert.Equal(t, "2027-06-01T00:00:00Z", *model.Certificates[0].NotAfter)
+	assert.Equal(t, "CN=second", *model.Certificates[1].Subject)
+	assert.Equal(t, "2026-06-01T00:00:00Z", *model.EarliestExpiration)
+}
+
+func TestIsEnabled(t *testing.T) {
+	assert.False(t, resource.IsEnabled(nil))
+	assert.False(t, resource.IsEnabled(&admin.UserSecurity{}))
+	assert.False(t, resource.IsEnabled(&admin.UserSecurity{CustomerX509: &admin.DBUserTLSX509Settings{Cas: ptr.String("")}}))
+	assert.True(t, resource.IsEnabled(&admin.UserSecurity{CustomerX509: &admin.DBUserTLSX509Settings{Cas: ptr.String("cas")}}))
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.Cas}
+var ReadRequiredFields = []string{constants.ProjectID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.Cas}
+var DeleteRequiredFields = []string{constants.ProjectID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-customer-x509-ca")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+	if err := ValidateCAChain(*currentModel.Cas, time.Now()); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	userSecurity, apiResp, err := conn.LDAPConfigurationApi.GetUserSecurity(context.Background(), *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	if IsEnabled(userSecurity) {
+		return progress_events.GetFailedEventByCode("customer-managed X.509 is already configured for the project", string(types.HandlerErrorCodeAlreadyExists)), nil
+	}
+
+	return saveCustomerX509(conn, currentModel, constants.CREATE, "Create Completed")
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	userSecurity, apiResp, err := conn.LDAPConfigurationApi.GetUserSecurity(context.Background(), *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+	if !IsEnabled(userSecurity) {
+		return progress_events.GetFailedEventByCode("customer-managed X.509 is not configured for the project", string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	resourceModel, err := GetCustomerX509CAModel(userSecurity, currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInternalFailure)), nil
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+	if err := ValidateCAChain(*currentModel.Cas, time.Now()); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	userSecurity, apiResp, err := conn.LDAPConfigurationApi.GetUserSecurity(context.Background(), *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+	if !IsEnabled(userSecurity) {
+		return progress_events.GetFailedEventByCode("customer-managed X.509 is not configured for the project", string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	return saveCustomerX509(conn, currentModel, constants.UPDATE, "Update Completed")
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	userSecurity, apiResp, err := conn.LDAPConfigurationApi.GetUserSecurity(ctx, *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+	if !IsEnabled(userSecurity) {
+		return progress_events.GetFailedEventByCode("customer-managed X.509 is not configured for the project", string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	if _, apiResp, err := conn.X509AuthenticationApi.DisableSecurityCustomerX509(ctx, *currentModel.ProjectId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: List")
+}
+
+// saveCustomerX509 is shared by Create and Update since Atlas uses the same PATCH endpoint to set the CA chain.
+func saveCustomerX509(conn *admin.APIClient, currentModel *Model, method constants.CfnFunctions, message string) (handler.ProgressEvent, error) {
+	userSecurity, apiResp, err := conn.LDAPConfigurationApi.UpdateUserSecurity(context.Background(), *currentModel.ProjectId, NewUserSecurityReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	resourceModel, err := GetCustomerX509CAModel(userSecurity, currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInternalFailure)), nil
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         message,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
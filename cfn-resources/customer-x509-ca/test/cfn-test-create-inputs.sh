@@ -0,0 +1,57 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+set -euo pipefail
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="default"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+projectName="${1:-$PROJECT_NAME}"
+echo "$projectName"
+projectId=$(atlas projects list --output json | jq --arg NAME "${projectName}" -r '.results[] | select(.name==$NAME) | .id')
+if [ -z "$projectId" ]; then
+	projectId=$(atlas projects create "${projectName}" --output=json | jq -r '.id')
+
+	echo -e "Created project \"${projectName}\" with id: ${projectId}\n"
+else
+	echo -e "FOUND project \"${projectName}\" with id: ${projectId}\n"
+fi
+echo -e "=====\nrun this command to clean up\n=====\nmongocli iam projects delete ${projectId} --force\n====="
+
+# generate one self-signed CA for create and another one for update
+certDir=$(mktemp -d)
+for name in create update; do
+	openssl req -x509 -newkey rsa:2048 -nodes -days 30 \
+		-subj "/CN=cfn-test-${name}-ca" \
+		-addext "basicConstraints=critical,CA:TRUE" \
+		-keyout "${certDir}/${name}.key" -out "${certDir}/${name}.pem" 2>/dev/null
+done
+
+cd "$(dirname "$0")" || exit
+for inputFile in inputs_*; do
+	outputFile=${inputFile//template./}
+	certFile="${certDir}/create.pem"
+	if [[ $inputFile == *update* ]]; then
+		certFile="${certDir}/update.pem"
+	fi
+	jq --arg ProjectId "$projectId" \
+		--arg profile "$profile" \
+		--rawfile cas "$certFile" \
+		'.Profile?|=$profile | .ProjectId?|=$ProjectId | .Cas?|=$cas' \
+		"$inputFile" >"../inputs/$outputFile"
+done
+rm -rf "$certDir"
+
+cd ..
+
+ls -l inputs
@@ -0,0 +1,15 @@
+#!/usr/bin/env bash
+
+# Run this script with the Makefile
+# make create-test-resources
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# setting projectName
+projectName="cfn-customer-x509-ca-$(date +%s)-$RANDOM"
+
+./test/cfn-test-create-inputs.sh "$projectName"
@@ -35,6 +35,17 @@ var UpdateRequiredFields = []string{constants.DatabaseName, constants.ProjectID,
 var DeleteRequiredFields = []string{constants.ProjectID, constants.DatabaseName, constants.Username}
 var ListRequiredFields = []string{constants.ProjectID}
 
+const (
+	oidcNone     = "NONE"
+	oidcIdpGroup = "IDP_GROUP"
+	oidcUser     = "USER"
+)
+
+var oidcDatabaseNames = map[string]string{
+	oidcIdpGroup: "admin",
+	oidcUser:     "$external",
+}
+
 func setup() {
 	util.SetupLogger("mongodb-atlas-database-user")
 }
@@ -73,7 +84,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
-	updateUserCFNIdentifier(currentModel)
+	UpdateUserCFNIdentifier(currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -110,6 +121,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	currentModel.LdapAuthType = databaseUser.LdapAuthType
 	currentModel.AWSIAMType = databaseUser.AwsIAMType
 	currentModel.X509Type = databaseUser.X509Type
+	currentModel.OidcAuthType = databaseUser.OidcAuthType
 	currentModel.Username = &databaseUser.Username
 	var roles []RoleDefinition
 
@@ -144,7 +156,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		scopes = append(scopes, scope)
 	}
 	currentModel.Scopes = scopes
-	updateUserCFNIdentifier(currentModel)
+	UpdateUserCFNIdentifier(currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -182,7 +194,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
-	updateUserCFNIdentifier(currentModel)
+	UpdateUserCFNIdentifier(currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -214,7 +226,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
-	updateUserCFNIdentifier(currentModel)
+	UpdateUserCFNIdentifier(currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -253,6 +265,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			Description:  databaseUser.Description,
 			LdapAuthType: databaseUser.LdapAuthType,
 			X509Type:     databaseUser.X509Type,
+			OidcAuthType: databaseUser.OidcAuthType,
 			Username:     &databaseUser.Username,
 			ProjectId:    currentModel.ProjectId,
 		}
@@ -281,7 +294,7 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		}
 
 		model.Labels = labels
-		updateUserCFNIdentifier(&model)
+		UpdateUserCFNIdentifier(&model)
 		dbUserModels = append(dbUserModels, model)
 	}
 
@@ -341,10 +354,17 @@ func setModel(currentModel *Model) (*admin.CloudDatabaseUser, error) {
 	if currentModel.X509Type == nil {
 		currentModel.X509Type = &none
 	}
+	if currentModel.OidcAuthType == nil {
+		currentModel.OidcAuthType = &none
+	}
+
+	if err := ValidateOidcAuthType(currentModel); err != nil {
+		return nil, err
+	}
 
 	if currentModel.Password == nil {
-		if (*currentModel.LdapAuthType == none) && (*currentModel.AWSIAMType == none) && (*currentModel.X509Type == none) {
-			err := fmt.Errorf("password cannot be empty if not LDAP or IAM or X509 is not provided")
+		if (*currentModel.LdapAuthType == none) && (*currentModel.AWSIAMType == none) && (*currentModel.X509Type == none) && (*currentModel.OidcAuthType == none) {
+			err := fmt.Errorf("password cannot be empty if not LDAP or IAM or X509 or OIDC is not provided")
 			return nil, err
 		}
 		currentModel.Password = aws.String("")
@@ -364,6 +384,7 @@ func setModel(currentModel *Model) (*admin.CloudDatabaseUser, error) {
 		LdapAuthType:    currentModel.LdapAuthType,
 		AwsIAMType:      currentModel.AWSIAMType,
 		X509Type:        currentModel.X509Type,
+		OidcAuthType:    currentModel.OidcAuthType,
 		DeleteAfterDate: util.StringPtrToTimePtr(currentModel.DeleteAfterDate),
 		Description:     currentModel.Description,
 	}
@@ -375,7 +396,29 @@ func setModel(currentModel *Model) (*admin.CloudDatabaseUser, error) {
 	return user, nil
 }
 
-func updateUserCFNIdentifier(model *Model) {
+// ValidateOidcAuthType enforces the Atlas constraints of OIDC principals: IdP groups (Workforce) authenticate against
+// the admin database, IdP users (Workload) against $external, and neither of them has a password.
+func ValidateOidcAuthType(model *Model) error {
+	oidcAuthType := aws.ToString(model.OidcAuthType)
+	if oidcAuthType == "" || oidcAuthType == oidcNone {
+		return nil
+	}
+
+	if util.IsStringPresent(model.Password) {
+		return fmt.Errorf("password cannot be provided when OidcAuthType is %s", oidcAuthType)
+	}
+	if expected := oidcDatabaseNames[oidcAuthType]; aws.ToString(model.DatabaseName) != expected {
+		return fmt.Errorf("database name must be %s when OidcAuthType is %s", expected, oidcAuthType)
+	}
+	return nil
+}
+
+func UpdateUserCFNIdentifier(model *Model) {
 	cfnid := fmt.Sprintf("%s-%s", *model.Username, *model.ProjectId)
+	// OIDC principals are named <IdP ID>/<group or user name>, and groups and users of the same IdP live in different
+	// databases, so the database name is needed to tell them apart.
+	if oidcAuthType := aws.ToString(model.OidcAuthType); oidcAuthType != "" && oidcAuthType != oidcNone {
+		cfnid = fmt.Sprintf("%s-%s-%s", *model.Username, aws.ToString(model.DatabaseName), *model.ProjectId)
+	}
 	model.UserCFNIdentifier = &cfnid
 }
@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/database-user/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOidcAuthType(t *testing.T) {
+	tests := []struct {
+		model       *resource.Model
+		name        string
+		expectedErr string
+	}{
+		{
+			name:  "Without OIDC",
+			model: &resource.Model{DatabaseName: ptr.String("admin"), Password: ptr.String("secret")},
+		},
+		{
+			name:  "OIDC None",
+			model: &resource.Model{OidcAuthType: ptr.String("NONE"), DatabaseName: ptr.String("admin"), Password: ptr.String("secret")},
+		},
+		{
+			name:  "IdP Group In Admin",
+			model: &resource.Model{OidcAuthType: ptr.String("IDP_GROUP"), DatabaseName: ptr.String("admin")},
+		},
+		{
+			name:        "IdP Group In External",
+			model:       &resource.Model{OidcAuthType: ptr.String("IDP_GROUP"), DatabaseName: ptr.String("$external")},
+			expectedErr: "database name must be admin when OidcAuthType is IDP_GROUP",
+		},
+		{
+			name:  "User In External",
+			model: &resource.Model{OidcAuthType: ptr.String("USER"), DatabaseName: ptr.String("$external")},
+		},
+		{
+			name:        "User In Admin",
+			model:       &resource.Model{OidcAuthType: ptr.String("USER"), DatabaseName: ptr.String("admin")},
+			expectedErr: "database name must be $external when OidcAuthType is USER",
+		},
+		{
+			name:        "With Password",
+			model:       &resource.Model{OidcAuthType: ptr.String("USER"), DatabaseName: ptr.String("$external"), Password: ptr.String("secret")},
+			expectedErr: "password cannot be provided when OidcAuthType is USER",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resource.ValidateOidcAuthType(tc.model)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestUpdateUserCFNIdentifier(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		name     string
+		expected string
+	}{
+		{
+			name:     "Without OIDC",
+			model:    &resource.Model{Username: ptr.String("user"), DatabaseName: ptr.String("admin"), ProjectId: ptr.String("projectId")},
+			expected: "user-projectId",
+		},
+		{
+			name:     "OIDC None",
+			model:    &resource.Model{Username: ptr.String("user"), DatabaseName: ptr.String("admin"), ProjectId: ptr.String("projectId"), OidcAuthType: ptr.String("NONE")},
+			expected: "user-projectId",
+		},
+		{
+			name:     "IdP Group",
+			model:    &resource.Model{Username: ptr.String("idp/group"), DatabaseName: ptr.String("admin"), ProjectId: ptr.String("projectId"), OidcAuthType: ptr.String("IDP_GROUP")},
+			expected: "idp/group-admin-projectId",
+		},
+		{
+			name:     "User",
+			model:    &resource.Model{Username: ptr.String("idp/group"), DatabaseName: ptr.String("$external"), ProjectId: ptr.String("projectId"), OidcAuthType: ptr.String("USER")},
+			expected: "idp/group-$external-projectId",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.UpdateUserCFNIdentifier(tc.model)
+			assert.Equal(t, tc.expected, *tc.model.UserCFNIdentifier)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	awsKmsConfigName         = "AwsKmsConfig"
+	azureKeyVaultConfigName  = "AzureKeyVaultConfig"
+	googleCloudKmsConfigName = "GoogleCloudKmsConfig"
+)
+
+var (
+	AzureRequiredFields = []string{"AzureKeyVaultConfig.ClientID", "AzureKeyVaultConfig.AzureEnvironment", "AzureKeyVaultConfig.SubscriptionID",
+		"AzureKeyVaultConfig.ResourceGroupName", "AzureKeyVaultConfig.KeyVaultName", "AzureKeyVaultConfig.KeyIdentifier",
+		"AzureKeyVaultConfig.Secret", "AzureKeyVaultConfig.TenantID"}
+	GoogleCloudRequiredFields = []string{"GoogleCloudKmsConfig.KeyVersionResourceID"}
+)
+
+// ValidateProviderConfigs checks that at least one key management provider is configured and that the providers
+// being enabled have all the properties Atlas needs. Configurations that only disable a provider don't need any.
+func ValidateProviderConfigs(model *Model) *handler.ProgressEvent {
+	if model.AwsKmsConfig == nil && model.AzureKeyVaultConfig == nil && model.GoogleCloudKmsConfig == nil {
+		return invalidRequest(fmt.Sprintf("one of %s, %s or %s must be set", awsKmsConfigName, azureKeyVaultConfigName, googleCloudKmsConfigName))
+	}
+
+	requiredFields := make([]string, 0)
+	if model.AwsKmsConfig != nil && isEnabling(model.AwsKmsConfig.Enabled) {
+		requiredFields = append(requiredFields, RoleID, CustomerMasterKeyID)
+	}
+	if model.AzureKeyVaultConfig != nil && isEnabling(model.AzureKeyVaultConfig.Enabled) {
+		requiredFields = append(requiredFields, AzureRequiredFields...)
+	}
+	if gcp := model.GoogleCloudKmsConfig; gcp != nil && isEnabling(gcp.Enabled) {
+		if !util.IsStringPresent(gcp.ServiceAccountKey) && !util.IsStringPresent(gcp.RoleId) {
+			return invalidRequest(fmt.Sprintf("%s requires either ServiceAccountKey or RoleId", googleCloudKmsConfigName))
+		}
+		requiredFields = append(requiredFields, GoogleCloudRequiredFields...)
+	}
+	return validator.ValidateModel(requiredFields, model)
+}
+
+// ValidateEnablesProvider checks that at least one provider is being enabled. A resource that only disables
+// providers would be reported as not found right after it is created.
+func ValidateEnablesProvider(model *Model) *handler.ProgressEvent {
+	if (model.AwsKmsConfig != nil && isEnabling(model.AwsKmsConfig.Enabled)) ||
+		(model.AzureKeyVaultConfig != nil && isEnabling(model.AzureKeyVaultConfig.Enabled)) ||
+		(model.GoogleCloudKmsConfig != nil && isEnabling(model.GoogleCloudKmsConfig.Enabled)) {
+		return nil
+	}
+	return invalidRequest(fmt.Sprintf("at least one of %s, %s or %s must be enabled on create", awsKmsConfigName, azureKeyVaultConfigName, googleCloudKmsConfigName))
+}
+
+// ValidateEnabledProviders checks that exactly the providers enabled in the model are enabled in Atlas.
+func ValidateEnabledProviders(model *Model, info *admin.EncryptionAtRest) error {
+	providers := []struct {
+		name       string
+		configured bool
+		enabled    bool
+	}{
+		{awsKmsConfigName, model.AwsKmsConfig != nil && isEnabling(model.AwsKmsConfig.Enabled), isAwsKmsEnabled(info)},
+		{azureKeyVaultConfigName, model.AzureKeyVaultConfig != nil && isEnabling(model.AzureKeyVaultConfig.Enabled), isAzureKeyVaultEnabled(info)},
+		{googleCloudKmsConfigName, model.GoogleCloudKmsConfig != nil && isEnabling(model.GoogleCloudKmsConfig.Enabled), isGoogleCloudKmsEnabled(info)},
+	}
+	for _, provider := range providers {
+		if provider.configured && !provider.enabled {
+			return fmt.Errorf("%s is not enabled in Atlas", provider.name)
+		}
+		if !provider.configured && provider.enabled {
+			return fmt.Errorf("%s is enabled in Atlas but not enabled in the resource", provider.name)
+		}
+	}
+	return nil
+}
+
+// IsEnabled reports whether encryption at rest is enabled with any key management provider.
+func IsEnabled(info *admin.EncryptionAtRest) bool {
+	return isAwsKmsEnabled(info) || isAzureKeyVaultEnabled(info) || isGoogleCloudKmsEnabled(info)
+}
+
+// NewEncryptionAtRestReq builds the encryption at rest configuration of the model. Providers that are enabled in
+// Atlas but not configured in the model are disabled.
+func NewEncryptionAtRestReq(model *Model, current *admin.EncryptionAtRest) *admin.EncryptionAtRest {
+	if model == nil {
+		return nil
+	}
+	disabled := func() *bool { return aws.Bool(false) }
+	req := &admin.EncryptionAtRest{}
+
+	if awsKms := model.AwsKmsConfig; awsKms != nil {
+		req.AwsKms = &admin.AWSKMSConfiguration{
+			Enabled:             awsKms.Enabled,
+			CustomerMasterKeyID: awsKms.CustomerMasterKeyID,
+			RoleId:              awsKms.RoleID,
+			Region:              awsKms.Region,
+		}
+	} else if isAwsKmsEnabled(current) {
+		req.AwsKms = &admin.AWSKMSConfiguration{Enabled: disabled()}
+	}
+
+	if azure := model.AzureKeyVaultConfig; azure != nil {
+		req.AzureKeyVault = &admin.AzureKeyVault{
+			Enabled:                  azure.Enabled,
+			ClientID:                 azure.ClientID,
+			AzureEnvironment:         azure.AzureEnvironment,
+			SubscriptionID:           azure.SubscriptionID,
+			ResourceGroupName:        azure.ResourceGroupName,
+			KeyVaultName:             azure.KeyVaultName,
+			KeyIdentifier:            azure.KeyIdentifier,
+			Secret:                   azure.Secret,
+			TenantID:                 azure.TenantID,
+			RequirePrivateNetworking: azure.RequirePrivateNetworking,
+		}
+	} else if isAzureKeyVaultEnabled(current) {
+		req.AzureKeyVault = &admin.AzureKeyVault{Enabled: disabled()}
+	}
+
+	if gcp := model.GoogleCloudKmsConfig; gcp != nil {
+		req.GoogleCloudKms = &admin.GoogleCloudKMS{
+			Enabled:              gcp.Enabled,
+			KeyVersionResourceID: gcp.KeyVersionResourceID,
+			ServiceAccountKey:    gcp.ServiceAccountKey,
+			RoleId:               gcp.RoleId,
+		}
+	} else if isGoogleCloudKmsEnabled(current) {
+		req.GoogleCloudKms = &admin.GoogleCloudKMS{Enabled: disabled()}
+	}
+	return req
+}
+
+// NewDisableEncryptionAtRestReq disables all the providers that are enabled in Atlas.
+func NewDisableEncryptionAtRestReq(current *admin.EncryptionAtRest) *admin.EncryptionAtRest {
+	return NewEncryptionAtRestReq(&Model{}, current)
+}
+
+// GetEncryptionAtRestModel reads back the providers that are configured in the model or enabled in Atlas.
+// Secrets are never returned by Atlas, so the ones in the model are kept.
+func GetEncryptionAtRestModel(info *admin.EncryptionAtRest, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if info == nil {
+		return model
+	}
+
+	if awsKms := info.AwsKms; awsKms != nil && (model.AwsKmsConfig != nil || isAwsKmsEnabled(info)) {
+		if model.AwsKmsConfig == nil {
+			model.AwsKmsConfig = new(AwsKmsConfig)
+		}
+		model.AwsKmsConfig.CustomerMasterKeyID = awsKms.CustomerMasterKeyID
+		model.AwsKmsConfig.Enabled = awsKms.Enabled
+		model.AwsKmsConfig.RoleID = awsKms.RoleId
+		model.AwsKmsConfig.Region = awsKms.Region
+		model.AwsKmsConfig.Valid = awsKms.Valid
+	}
+
+	if azure := info.AzureKeyVault; azure != nil && (model.AzureKeyVaultConfig != nil || isAzureKeyVaultEnabled(info)) {
+		if model.AzureKeyVaultConfig == nil {
+			model.AzureKeyVaultConfig = new(AzureKeyVaultConfig)
+		}
+		model.AzureKeyVaultConfig.Enabled = azure.Enabled
+		model.AzureKeyVaultConfig.ClientID = azure.ClientID
+		model.AzureKeyVaultConfig.AzureEnvironment = azure.AzureEnvironment
+		model.AzureKeyVaultConfig.SubscriptionID = azure.SubscriptionID
+		model.AzureKeyVaultConfig.ResourceGroupName = azure.ResourceGroupName
+		model.AzureKeyVaultConfig.KeyVaultName = azure.KeyVaultName
+		model.AzureKeyVaultConfig.KeyIdentifier = azure.KeyIdentifier
+		model.AzureKeyVaultConfig.TenantID = azure.TenantID
+		model.AzureKeyVaultConfig.RequirePrivateNetworking = azure.RequirePrivateNetworking
+		model.AzureKeyVaultConfig.Valid = azure.Valid
+	}
+
+	if gcp := info.GoogleCloudKms; gcp != nil && (model.GoogleCloudKmsConfig != nil || isGoogleCloudKmsEnabled(info)) {
+		if model.GoogleCloudKmsConfig == nil {
+			model.GoogleCloudKmsConfig = new(GoogleCloudKmsConfig)
+		}
+		model.GoogleCloudKmsConfig.Enabled = gcp.Enabled
+		model.GoogleCloudKmsConfig.KeyVersionResourceID = gcp.KeyVersionResourceID
+		model.GoogleCloudKmsConfig.RoleId = gcp.RoleId
+		model.GoogleCloudKmsConfig.Valid = gcp.Valid
+	}
+	return model
+}
+
+func isEnabling(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
+func isAwsKmsEnabled(info *admin.EncryptionAtRest) bool {
+	return info != nil && info.AwsKms != nil && aws.ToBool(info.AwsKms.Enabled)
+}
+
+func isAzureKeyVaultEnabled(info *admin.EncryptionAtRest) bool {
+	return info != nil && info.AzureKeyVault != nil && aws.ToBool(info.AzureKeyVault.Enabled)
+}
+
+func isGoogleCloudKmsEnabled(info *admin.EncryptionAtRest) bool {
+	return info != nil && info.GoogleCloudKms != nil && aws.ToBool(info.GoogleCloudKms.Enabled)
+}
+
+func invalidRequest(message string) *handler.ProgressEvent {
+	return util.Pointer(progressevent.GetFailedEventByCode(message, string(types.HandlerErrorCodeInvalidRequest)))
+}
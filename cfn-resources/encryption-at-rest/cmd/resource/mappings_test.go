@@ -0,0 +1,312 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/encryption-at-rest/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func azureConfig() *resource.AzureKeyVaultConfig {
+	return &resource.AzureKeyVaultConfig{
+		Enabled:                  ptr.Bool(true),
+		ClientID:                 ptr.String("client-id"),
+		AzureEnvironment:         ptr.String("AZURE"),
+		SubscriptionID:           ptr.String("subscription-id"),
+		ResourceGroupName:        ptr.String("resource-group"),
+		KeyVaultName:             ptr.String("key-vault"),
+		KeyIdentifier:            ptr.String("https://key-vault.vault.azure.net/keys/key/1"),
+		Secret:                   ptr.String("secret"),
+		TenantID:                 ptr.String("tenant-id"),
+		RequirePrivateNetworking: ptr.Bool(true),
+	}
+}
+
+func TestValidateEnablesProvider(t *testing.T) {
+	tests := []struct {
+		input         *resource.Model
+		name          string
+		expectedError bool
+	}{
+		{
+			name:          "No provider",
+			input:         &resource.Model{ProjectId: ptr.String("projectId")},
+			expectedError: true,
+		},
+		{
+			name: "Only disabled providers",
+			input: &resource.Model{
+				AwsKmsConfig:         &resource.AwsKmsConfig{Enabled: ptr.Bool(false)},
+				GoogleCloudKmsConfig: &resource.GoogleCloudKmsConfig{Enabled: ptr.Bool(false)},
+			},
+			expectedError: true,
+		},
+		{
+			name: "One enabled provider",
+			input: &resource.Model{
+				AwsKmsConfig:        &resource.AwsKmsConfig{Enabled: ptr.Bool(false)},
+				AzureKeyVaultConfig: azureConfig(),
+			},
+			expectedError: false,
+		},
+		{
+			name: "Enabled by default",
+			input: &resource.Model{
+				AwsKmsConfig: &resource.AwsKmsConfig{RoleID: ptr.String("roleId")},
+			},
+			expectedError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, resource.ValidateEnablesProvider(tt.input) != nil)
+		})
+	}
+}
+
+func TestValidateProviderConfigs(t *testing.T) {
+	tests := []struct {
+		input         *resource.Model
+		name          string
+		expectedError bool
+	}{
+		{
+			name:          "No provider",
+			input:         &resource.Model{ProjectId: ptr.String("projectId")},
+			expectedError: true,
+		},
+		{
+			name: "AWS missing key",
+			input: &resource.Model{
+				AwsKmsConfig: &resource.AwsKmsConfig{Enabled: ptr.Bool(true), RoleID: ptr.String("roleId")},
+			},
+			expectedError: true,
+		},
+		{
+			name: "AWS disabled without key",
+			input: &resource.Model{
+				AwsKmsConfig: &resource.AwsKmsConfig{Enabled: ptr.Bool(false)},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "Azure complete",
+			input:         &resource.Model{AzureKeyVaultConfig: azureConfig()},
+			expectedError: false,
+		},
+		{
+			name: "Azure missing secret",
+			input: &resource.Model{
+				AzureKeyVaultConfig: &resource.AzureKeyVaultConfig{
+					Enabled:  ptr.Bool(true),
+					ClientID: ptr.String("client-id"),
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "GCP with role",
+			input: &resource.Model{
+				GoogleCloudKmsConfig: &resource.GoogleCloudKmsConfig{
+					Enabled:              ptr.Bool(true),
+					KeyVersionResourceID: ptr.String("projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"),
+					RoleId:               ptr.String("roleId"),
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "GCP without credentials",
+			input: &resource.Model{
+				GoogleCloudKmsConfig: &resource.GoogleCloudKmsConfig{
+					Enabled:              ptr.Bool(true),
+					KeyVersionResourceID: ptr.String("projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"),
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, resource.ValidateProviderConfigs(tt.input) != nil)
+		})
+	}
+}
+
+func TestNewEncryptionAtRestReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		current  *admin.EncryptionAtRest
+		expected *admin.EncryptionAtRest
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:  "Azure only",
+			input: &resource.Model{AzureKeyVaultConfig: azureConfig()},
+			current: &admin.EncryptionAtRest{
+				AzureKeyVault: &admin.AzureKeyVault{Enabled: ptr.Bool(false)},
+			},
+			expected: &admin.EncryptionAtRest{
+				AzureKeyVault: &admin.AzureKeyVault{
+					Enabled:                  ptr.Bool(true),
+					ClientID:                 ptr.String("client-id"),
+					AzureEnvironment:         ptr.String("AZURE"),
+					SubscriptionID:           ptr.String("subscription-id"),
+					ResourceGroupName:        ptr.String("resource-group"),
+					KeyVaultName:             ptr.String("key-vault"),
+					KeyIdentifier:            ptr.String("https://key-vault.vault.azure.net/keys/key/1"),
+					Secret:                   ptr.String("secret"),
+					TenantID:                 ptr.String("tenant-id"),
+					RequirePrivateNetworking: ptr.Bool(true),
+				},
+			},
+		},
+		{
+			name: "Disables providers not in the model",
+			input: &resource.Model{
+				GoogleCloudKmsConfig: &resource.GoogleCloudKmsConfig{
+					Enabled:              ptr.Bool(true),
+					KeyVersionResourceID: ptr.String("key"),
+					RoleId:               ptr.String("roleId"),
+				},
+			},
+			current: &admin.EncryptionAtRest{
+				AwsKms:        &admin.AWSKMSConfiguration{Enabled: ptr.Bool(true)},
+				AzureKeyVault: &admin.AzureKeyVault{Enabled: ptr.Bool(false)},
+			},
+			expected: &admin.EncryptionAtRest{
+				AwsKms: &admin.AWSKMSConfiguration{Enabled: ptr.Bool(false)},
+				GoogleCloudKms: &admin.GoogleCloudKMS{
+					Enabled:              ptr.Bool(true),
+					KeyVersionResourceID: ptr.String("key"),
+					RoleId:               ptr.String("roleId"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.NewEncryptionAtRestReq(tt.input, tt.current))
+		})
+	}
+}
+
+func TestNewDisableEncryptionAtRestReq(t *testing.T) {
+	current := &admin.EncryptionAtRest{
+		AwsKms:         &admin.AWSKMSConfiguration{Enabled: ptr.Bool(true)},
+		AzureKeyVault:  &admin.AzureKeyVault{Enabled: ptr.Bool(false)},
+		GoogleCloudKms: &admin.GoogleCloudKMS{Enabled: ptr.Bool(true)},
+	}
+	expected := &admin.EncryptionAtRest{
+		AwsKms:         &admin.AWSKMSConfiguration{Enabled: ptr.Bool(false)},
+		GoogleCloudKms: &admin.GoogleCloudKMS{Enabled: ptr.Bool(false)},
+	}
+	assert.Equal(t, expected, resource.NewDisableEncryptionAtRestReq(current))
+}
+
+func TestValidateEnabledProviders(t *testing.T) {
+	tests := []struct {
+		input         *resource.Model
+		info          *admin.EncryptionAtRest
+		name          string
+		expectedError bool
+	}{
+		{
+			name:  "Exactly the configured provider enabled",
+			input: &resource.Model{AzureKeyVaultConfig: azureConfig()},
+			info: &admin.EncryptionAtRest{
+				AwsKms:        &admin.AWSKMSConfiguration{Enabled: ptr.Bool(false)},
+				AzureKeyVault: &admin.AzureKeyVault{Enabled: ptr.Bool(true)},
+			},
+			expectedError: false,
+		},
+		{
+			name:  "Configured provider not enabled",
+			input: &resource.Model{AzureKeyVaultConfig: azureConfig()},
+			info: &admin.EncryptionAtRest{
+				AzureKeyVault: &admin.AzureKeyVault{Enabled: ptr.Bool(false)},
+			},
+			expectedError: true,
+		},
+		{
+			name:  "Provider enabled but not configured",
+			input: &resource.Model{AzureKeyVaultConfig: azureConfig()},
+			info: &admin.EncryptionAtRest{
+				AzureKeyVault:  &admin.AzureKeyVault{Enabled: ptr.Bool(true)},
+				GoogleCloudKms: &admin.GoogleCloudKMS{Enabled: ptr.Bool(true)},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, resource.ValidateEnabledProviders(tt.input, tt.info) != nil)
+		})
+	}
+}
+
+func TestGetEncryptionAtRestModel(t *testing.T) {
+	info := &admin.EncryptionAtRest{
+		AwsKms: &admin.AWSKMSConfiguration{Enabled: ptr.Bool(false)},
+		AzureKeyVault: &admin.AzureKeyVault{
+			Enabled:                  ptr.Bool(true),
+			ClientID:                 ptr.String("client-id"),
+			AzureEnvironment:         ptr.String("AZURE"),
+			SubscriptionID:           ptr.String("subscription-id"),
+			ResourceGroupName:        ptr.String("resource-group"),
+			KeyVaultName:             ptr.String("key-vault"),
+			KeyIdentifier:            ptr.String("https://key-vault.vault.azure.net/keys/key/1"),
+			TenantID:                 ptr.String("tenant-id"),
+			RequirePrivateNetworking: ptr.Bool(true),
+			Valid:                    ptr.Bool(true),
+		},
+		GoogleCloudKms: &admin.GoogleCloudKMS{
+			Enabled:              ptr.Bool(true),
+			KeyVersionResourceID: ptr.String("key"),
+			Valid:                ptr.Bool(false),
+		},
+	}
+	currentModel := &resource.Model{
+		ProjectId:           ptr.String("projectId"),
+		AzureKeyVaultConfig: &resource.AzureKeyVaultConfig{Secret: ptr.String("secret")},
+	}
+
+	expectedAzure := azureConfig()
+	expectedAzure.Valid = ptr.Bool(true)
+	expected := &resource.Model{
+		ProjectId:           ptr.String("projectId"),
+		AzureKeyVaultConfig: expectedAzure,
+		GoogleCloudKmsConfig: &resource.GoogleCloudKmsConfig{
+			Enabled:              ptr.Bool(true),
+			KeyVersionResourceID: ptr.String("key"),
+			Valid:                ptr.Bool(false),
+		},
+	}
+	assert.Equal(t, expected, resource.GetEncryptionAtRestModel(info, currentModel))
+}
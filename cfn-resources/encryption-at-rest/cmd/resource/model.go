@@ -4,10 +4,12 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	AwsKmsConfig *AwsKmsConfig `json:",omitempty"`
-	Profile      *string       `json:",omitempty"`
-	ProjectId    *string       `json:",omitempty"`
-	Id           *string       `json:",omitempty"`
+	AwsKmsConfig         *AwsKmsConfig         `json:",omitempty"`
+	AzureKeyVaultConfig  *AzureKeyVaultConfig  `json:",omitempty"`
+	GoogleCloudKmsConfig *GoogleCloudKmsConfig `json:",omitempty"`
+	Profile              *string               `json:",omitempty"`
+	ProjectId            *string               `json:",omitempty"`
+	Id                   *string               `json:",omitempty"`
 }
 
 // AwsKmsConfig is autogenerated from the json schema
@@ -16,4 +18,29 @@ type AwsKmsConfig struct {
 	CustomerMasterKeyID *string `json:",omitempty"`
 	Enabled             *bool   `json:",omitempty"`
 	Region              *string `json:",omitempty"`
+	Valid               *bool   `json:",omitempty"`
+}
+
+// AzureKeyVaultConfig is autogenerated from the json schema
+type AzureKeyVaultConfig struct {
+	Enabled                  *bool   `json:",omitempty"`
+	ClientID                 *string `json:",omitempty"`
+	AzureEnvironment         *string `json:",omitempty"`
+	SubscriptionID           *string `json:",omitempty"`
+	ResourceGroupName        *string `json:",omitempty"`
+	KeyVaultName             *string `json:",omitempty"`
+	KeyIdentifier            *string `json:",omitempty"`
+	Secret                   *string `json:",omitempty"`
+	TenantID                 *string `json:",omitempty"`
+	RequirePrivateNetworking *bool   `json:",omitempty"`
+	Valid                    *bool   `json:",omitempty"`
+}
+
+// GoogleCloudKmsConfig is autogenerated from the json schema
+type GoogleCloudKmsConfig struct {
+	Enabled              *bool   `json:",omitempty"`
+	KeyVersionResourceID *string `json:",omitempty"`
+	ServiceAccountKey    *string `json:",omitempty"`
+	RoleId               *string `json:",omitempty"`
+	Valid                *bool   `json:",omitempty"`
 }
@@ -22,7 +22,7 @@ import (
 	"math/big"
 	"strconv"
 
-	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -37,7 +37,7 @@ import (
 var (
 	CustomerMasterKeyID           = "AwsKmsConfig.CustomerMasterKeyID"
 	RoleID                        = "AwsKmsConfig.RoleID"
-	CreateAndUpdateRequiredFields = []string{RoleID, CustomerMasterKeyID, constants.ProjectID}
+	CreateAndUpdateRequiredFields = []string{constants.ProjectID}
 	ReadAndDeleteRequiredFields   = []string{constants.ProjectID}
 )
 
@@ -51,16 +51,26 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if err := validator.ValidateModel(CreateAndUpdateRequiredFields, currentModel); err != nil {
 		return *err, nil
 	}
+	if pe := ValidateProviderConfigs(currentModel); pe != nil {
+		return *pe, nil
+	}
+	if pe := ValidateEnablesProvider(currentModel); pe != nil {
+		return *pe, nil
+	}
 
 	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
 	if pe != nil {
 		return *pe, nil
 	}
 
-	_, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, currentModel.getParams()).Execute()
+	info, resp, err := client.AtlasSDK.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
+
+	if pe := update(client.AtlasSDK, currentModel, info); pe != nil {
+		return *pe, nil
+	}
 	currentModel.Id = aws.String(strconv.FormatInt(randInt64(), 10))
 
 	return handler.ProgressEvent{
@@ -82,7 +92,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *pe, nil
 	}
 
-	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
+	info, resp, err := client.AtlasSDK.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -91,15 +101,10 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *pe, nil
 	}
 
-	currentModel.AwsKmsConfig.CustomerMasterKeyID = info.AwsKms.CustomerMasterKeyID
-	currentModel.AwsKmsConfig.Enabled = info.AwsKms.Enabled
-	currentModel.AwsKmsConfig.RoleID = info.AwsKms.RoleId
-	currentModel.AwsKmsConfig.Region = info.AwsKms.Region
-
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Read Complete",
-		ResourceModel:   currentModel,
+		ResourceModel:   GetEncryptionAtRestModel(info, currentModel),
 	}, nil
 }
 
@@ -109,13 +114,16 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	if err := validator.ValidateModel(CreateAndUpdateRequiredFields, currentModel); err != nil {
 		return *err, nil
 	}
+	if pe := ValidateProviderConfigs(currentModel); pe != nil {
+		return *pe, nil
+	}
 
 	client, pe := util.NewAtlasClient(&req, currentModel.Profile)
 	if pe != nil {
 		return *pe, nil
 	}
 
-	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
+	info, resp, err := client.AtlasSDK.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -124,9 +132,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *pe, nil
 	}
 
-	_, resp, err = client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, currentModel.getParams()).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
+	if pe := update(client.AtlasSDK, currentModel, info); pe != nil {
+		return *pe, nil
 	}
 
 	return handler.ProgressEvent{
@@ -148,7 +155,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *pe, nil
 	}
 
-	info, resp, err := client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
+	info, resp, err := client.AtlasSDK.EncryptionAtRestUsingCustomerKeyManagementApi.GetEncryptionAtRest(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -157,10 +164,8 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *pe, nil
 	}
 
-	params := &admin20231115002.EncryptionAtRest{
-		AwsKms: &admin20231115002.AWSKMSConfiguration{Enabled: aws.Bool(false)},
-	}
-	_, resp, err = client.Atlas20231115002.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, params).Execute()
+	params := NewDisableEncryptionAtRestReq(info)
+	_, resp, err = client.AtlasSDK.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, params).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -175,8 +180,23 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	return handler.ProgressEvent{}, errors.New("not implemented: List")
 }
 
-func validateExist(info *admin20231115002.EncryptionAtRest) *handler.ProgressEvent {
-	if info != nil && info.AwsKms != nil && aws.ToBool(info.AwsKms.Enabled) {
+// update applies the providers configured in the model, disabling the other ones, and checks that Atlas enabled
+// exactly the configured providers.
+func update(client *admin.APIClient, currentModel *Model, current *admin.EncryptionAtRest) *handler.ProgressEvent {
+	params := NewEncryptionAtRestReq(currentModel, current)
+	info, resp, err := client.EncryptionAtRestUsingCustomerKeyManagementApi.UpdateEncryptionAtRest(context.Background(), *currentModel.ProjectId, params).Execute()
+	if err != nil {
+		return util.Pointer(progressevent.GetFailedEventByResponse(err.Error(), resp))
+	}
+	if err := ValidateEnabledProviders(currentModel, info); err != nil {
+		return util.Pointer(progressevent.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)))
+	}
+	GetEncryptionAtRestModel(info, currentModel)
+	return nil
+}
+
+func validateExist(info *admin.EncryptionAtRest) *handler.ProgressEvent {
+	if IsEnabled(info) {
 		return nil
 	}
 	return &handler.ProgressEvent{
@@ -192,14 +212,3 @@ func randInt64() int64 {
 	}
 	return val.Int64()
 }
-
-func (m *Model) getParams() *admin20231115002.EncryptionAtRest {
-	return &admin20231115002.EncryptionAtRest{
-		AwsKms: &admin20231115002.AWSKMSConfiguration{
-			Enabled:             m.AwsKmsConfig.Enabled,
-			CustomerMasterKeyID: m.AwsKmsConfig.CustomerMasterKeyID,
-			RoleId:              m.AwsKmsConfig.RoleID,
-			Region:              m.AwsKmsConfig.Region,
-		},
-	}
-}
@@ -0,0 +1,216 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const (
+	SAML               = "SAML"
+	OIDC               = "OIDC"
+	WorkforceIdpType   = "WORKFORCE"
+	WorkloadIdpType    = "WORKLOAD"
+	GroupAuthorization = "GROUP"
+)
+
+// ValidateProtocolFields checks that only the properties that apply to the configured Protocol and IdpType are set.
+func ValidateProtocolFields(model *Model) error {
+	protocol := util.SafeString(model.Protocol)
+	idpType := util.SafeString(model.IdpType)
+
+	samlFields := []protocolField{
+		{"SsoUrl", model.SsoUrl != nil},
+		{"SsoDebugEnabled", model.SsoDebugEnabled != nil},
+		{"RequestBinding", model.RequestBinding != nil},
+		{"ResponseSignatureAlgorithm", model.ResponseSignatureAlgorithm != nil},
+		{"Slug", model.Slug != nil},
+		{"Status", model.Status != nil},
+	}
+	oidcFields := []protocolField{
+		{"Audience", model.Audience != nil},
+		{"AuthorizationType", model.AuthorizationType != nil},
+		{"ClientId", model.ClientId != nil},
+		{"GroupsClaim", model.GroupsClaim != nil},
+		{"UserClaim", model.UserClaim != nil},
+		{"RequestedScopes", len(model.RequestedScopes) > 0},
+	}
+
+	switch protocol {
+	case SAML:
+		if idpType == WorkloadIdpType {
+			return fmt.Errorf("%s IdpType is only supported for %s identity providers", WorkloadIdpType, OIDC)
+		}
+		if field := firstSetField(oidcFields); field != "" {
+			return fmt.Errorf("%s can't be set for %s identity providers", field, SAML)
+		}
+	case OIDC:
+		if field := firstSetField(samlFields); field != "" {
+			return fmt.Errorf("%s can't be set for %s identity providers", field, OIDC)
+		}
+		if idpType == WorkloadIdpType && (model.ClientId != nil || len(model.RequestedScopes) > 0) {
+			return fmt.Errorf("%s identity providers don't support ClientId or RequestedScopes", WorkloadIdpType)
+		}
+		if util.SafeString(model.AuthorizationType) == GroupAuthorization && !util.IsStringPresent(model.GroupsClaim) {
+			return fmt.Errorf("%s AuthorizationType requires GroupsClaim to be set", GroupAuthorization)
+		}
+	default:
+		return fmt.Errorf("unsupported Protocol %q, must be either %s or %s", protocol, SAML, OIDC)
+	}
+	return nil
+}
+
+type protocolField struct {
+	name string
+	set  bool
+}
+
+func firstSetField(fields []protocolField) string {
+	for _, field := range fields {
+		if field.set {
+			return field.name
+		}
+	}
+	return ""
+}
+
+func NewOidcIdentityProviderCreateReq(model *Model) *admin.FederationOidcIdentityProviderUpdate {
+	if model == nil {
+		return nil
+	}
+	return &admin.FederationOidcIdentityProviderUpdate{
+		Audience:          model.Audience,
+		AuthorizationType: model.AuthorizationType,
+		Description:       model.Description,
+		DisplayName:       model.DisplayName,
+		GroupsClaim:       model.GroupsClaim,
+		IdpType:           model.IdpType,
+		IssuerUri:         model.IssuerUri,
+		Protocol:          model.Protocol,
+		UserClaim:         model.UserClaim,
+		AssociatedDomains: stringSlicePtr(model.AssociatedDomains),
+		ClientId:          model.ClientId,
+		RequestedScopes:   stringSlicePtr(model.RequestedScopes),
+	}
+}
+
+func NewIdentityProviderUpdateReq(model *Model) *admin.FederationIdentityProviderUpdate {
+	if model == nil {
+		return nil
+	}
+	updateReq := &admin.FederationIdentityProviderUpdate{
+		Description:       model.Description,
+		DisplayName:       model.DisplayName,
+		IdpType:           model.IdpType,
+		IssuerUri:         model.IssuerUri,
+		Protocol:          model.Protocol,
+		AssociatedDomains: stringSlicePtr(model.AssociatedDomains),
+	}
+	if util.SafeString(model.Protocol) == SAML {
+		updateReq.SsoUrl = model.SsoUrl
+		updateReq.SsoDebugEnabled = model.SsoDebugEnabled
+		updateReq.RequestBinding = model.RequestBinding
+		updateReq.ResponseSignatureAlgorithm = model.ResponseSignatureAlgorithm
+		updateReq.Slug = model.Slug
+		updateReq.Status = model.Status
+		return updateReq
+	}
+	updateReq.Audience = model.Audience
+	updateReq.AuthorizationType = model.AuthorizationType
+	updateReq.GroupsClaim = model.GroupsClaim
+	updateReq.UserClaim = model.UserClaim
+	updateReq.ClientId = model.ClientId
+	updateReq.RequestedScopes = stringSlicePtr(model.RequestedScopes)
+	return updateReq
+}
+
+// NewIdentityProviderFromOidc converts the response of an OIDC identity provider creation into the generic identity provider representation.
+func NewIdentityProviderFromOidc(oidc *admin.FederationOidcIdentityProvider) *admin.FederationIdentityProvider {
+	if oidc == nil {
+		return nil
+	}
+	return &admin.FederationIdentityProvider{
+		AssociatedOrgs:    oidc.AssociatedOrgs,
+		Audience:          oidc.Audience,
+		AuthorizationType: oidc.AuthorizationType,
+		CreatedAt:         oidc.CreatedAt,
+		Description:       oidc.Description,
+		DisplayName:       oidc.DisplayName,
+		GroupsClaim:       oidc.GroupsClaim,
+		Id:                oidc.Id,
+		IdpType:           oidc.IdpType,
+		IssuerUri:         oidc.IssuerUri,
+		OktaIdpId:         oidc.OktaIdpId,
+		Protocol:          oidc.Protocol,
+		UpdatedAt:         oidc.UpdatedAt,
+		UserClaim:         oidc.UserClaim,
+		AssociatedDomains: oidc.AssociatedDomains,
+		ClientId:          oidc.ClientId,
+		RequestedScopes:   oidc.RequestedScopes,
+	}
+}
+
+func GetIdentityProviderModel(idp *admin.FederationIdentityProvider, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if idp == nil {
+		return model
+	}
+
+	model.IdpId = util.StringPtr(idp.Id)
+	model.OktaIdpId = util.StringPtr(idp.OktaIdpId)
+	model.Protocol = idp.Protocol
+	model.IdpType = idp.IdpType
+	model.DisplayName = idp.DisplayName
+	model.Description = idp.Description
+	model.IssuerUri = idp.IssuerUri
+	model.AssociatedDomains = idp.GetAssociatedDomains()
+	model.CreatedAt = util.TimePtrToStringPtr(idp.CreatedAt)
+	model.UpdatedAt = util.TimePtrToStringPtr(idp.UpdatedAt)
+
+	if util.SafeString(idp.Protocol) == SAML {
+		model.SsoUrl = idp.SsoUrl
+		model.SsoDebugEnabled = idp.SsoDebugEnabled
+		model.RequestBinding = idp.RequestBinding
+		model.ResponseSignatureAlgorithm = idp.ResponseSignatureAlgorithm
+		model.Slug = idp.Slug
+		model.Status = idp.Status
+		model.AcsUrl = idp.AcsUrl
+		model.AudienceUri = idp.AudienceUri
+		return model
+	}
+
+	model.Audience = idp.Audience
+	model.AuthorizationType = idp.AuthorizationType
+	model.ClientId = idp.ClientId
+	model.GroupsClaim = idp.GroupsClaim
+	model.UserClaim = idp.UserClaim
+	model.RequestedScopes = idp.GetRequestedScopes()
+	return model
+}
+
+func stringSlicePtr(values []string) *[]string {
+	if values == nil {
+		return nil
+	}
+	return &values
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/federated-settings-identity-provider/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProtocolFields(t *testing.T) {
+	tests := []struct {
+		input   *resource.Model
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "OIDC workforce",
+			input: &resource.Model{
+				Protocol:          ptr.String(resource.OIDC),
+				IdpType:           ptr.String(resource.WorkforceIdpType),
+				AuthorizationType: ptr.String(resource.GroupAuthorization),
+				GroupsClaim:       ptr.String("groups"),
+				ClientId:          ptr.String("clientId"),
+				RequestedScopes:   []string{"openid"},
+			},
+		},
+		{
+			name: "OIDC workload with ClientId",
+			input: &resource.Model{
+				Protocol: ptr.String(resource.OIDC),
+				IdpType:  ptr.String(resource.WorkloadIdpType),
+				ClientId: ptr.String("clientId"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "OIDC group authorization without GroupsClaim",
+			input: &resource.Model{
+				Protocol:          ptr.String(resource.OIDC),
+				AuthorizationType: ptr.String(resource.GroupAuthorization),
+			},
+			wantErr: true,
+		},
+		{
+			name: "OIDC with SAML field",
+			input: &resource.Model{
+				Protocol: ptr.String(resource.OIDC),
+				SsoUrl:   ptr.String("https://sso.example.com"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "SAML workforce",
+			input: &resource.Model{
+				Protocol:       ptr.String(resource.SAML),
+				SsoUrl:         ptr.String("https://sso.example.com"),
+				RequestBinding: ptr.String("HTTP-POST"),
+			},
+		},
+		{
+			name: "SAML workload",
+			input: &resource.Model{
+				Protocol: ptr.String(resource.SAML),
+				IdpType:  ptr.String(resource.WorkloadIdpType),
+			},
+			wantErr: true,
+		},
+		{
+			name: "SAML with OIDC field",
+			input: &resource.Model{
+				Protocol: ptr.String(resource.SAML),
+				Audience: ptr.String("audience"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.ValidateProtocolFields(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewIdentityProviderUpdateReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin.FederationIdentityProviderUpdate
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "SAML ignores OIDC fields",
+			input: &resource.Model{
+				Protocol:    ptr.String(resource.SAML),
+				DisplayName: ptr.String("saml-idp"),
+				SsoUrl:      ptr.String("https://sso.example.com"),
+				Status:      ptr.String("ACTIVE"),
+				Audience:    ptr.String("audience"),
+			},
+			expected: &admin.FederationIdentityProviderUpdate{
+				Protocol:    ptr.String(resource.SAML),
+				DisplayName: ptr.String("saml-idp"),
+				SsoUrl:      ptr.String("https://sso.example.com"),
+				Status:      ptr.String("ACTIVE"),
+			},
+		},
+		{
+			name: "OIDC ignores SAML fields",
+			input: &resource.Model{
+				Protocol:        ptr.String(resource.OIDC),
+				DisplayName:     ptr.String("oidc-idp"),
+				Audience:        ptr.String("audience"),
+				RequestedScopes: []string{"openid"},
+				SsoUrl:          ptr.String("https://sso.example.com"),
+			},
+			expected: &admin.FederationIdentityProviderUpdate{
+				Protocol:        ptr.String(resource.OIDC),
+				DisplayName:     ptr.String("oidc-idp"),
+				Audience:        ptr.String("audience"),
+				RequestedScopes: &[]string{"openid"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewIdentityProviderUpdateReq(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetIdentityProviderModel(t *testing.T) {
+	const (
+		idpID     = "65d6c4f9e3e5f0356a1b2c3d"
+		oktaIdpID = "0oa1b2c3d4e5f6g7h8i9"
+	)
+	tests := []struct {
+		input    *admin.FederationIdentityProvider
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "OIDC identity provider",
+			input: &admin.FederationIdentityProvider{
+				Id:                idpID,
+				OktaIdpId:         oktaIdpID,
+				Protocol:          ptr.String(resource.OIDC),
+				IdpType:           ptr.String(resource.WorkloadIdpType),
+				DisplayName:       ptr.String("oidc-idp"),
+				Audience:          ptr.String("audience"),
+				AuthorizationType: ptr.String("USER"),
+				UserClaim:         ptr.String("sub"),
+				AcsUrl:            ptr.String("https://acs.example.com"),
+			},
+			expected: &resource.Model{
+				IdpId:             ptr.String(idpID),
+				OktaIdpId:         ptr.String(oktaIdpID),
+				Protocol:          ptr.String(resource.OIDC),
+				IdpType:           ptr.String(resource.WorkloadIdpType),
+				DisplayName:       ptr.String("oidc-idp"),
+				Audience:          ptr.String("audience"),
+				AuthorizationType: ptr.String("USER"),
+				UserClaim:         ptr.String("sub"),
+			},
+		},
+		{
+			name: "SAML identity provider",
+			input: &admin.FederationIdentityProvider{
+				Id:          idpID,
+				OktaIdpId:   oktaIdpID,
+				Protocol:    ptr.String(resource.SAML),
+				DisplayName: ptr.String("saml-idp"),
+				SsoUrl:      ptr.String("https://sso.example.com"),
+				AcsUrl:      ptr.String("https://acs.example.com"),
+				Audience:    ptr.String("audience"),
+			},
+			expected: &resource.Model{
+				IdpId:       ptr.String(idpID),
+				OktaIdpId:   ptr.String(oktaIdpID),
+				Protocol:    ptr.String(resource.SAML),
+				DisplayName: ptr.String("saml-idp"),
+				SsoUrl:      ptr.String("https://sso.example.com"),
+				AcsUrl:      ptr.String("https://acs.example.com"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetIdentityProviderModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	IdpID = "IdpId"
+
+	SAMLCreateNotSupported = "SAML identity providers can't be created with the Atlas Admin API. Configure the identity provider in the Federation Management Console and import it into the stack"
+)
+
+var CreateRequiredFields = []string{constants.FederationSettingsID, "Protocol", "DisplayName", "IssuerUri"}
+var ReadRequiredFields = []string{constants.FederationSettingsID, IdpID}
+var UpdateRequiredFields = []string{constants.FederationSettingsID, IdpID, "Protocol"}
+var DeleteRequiredFields = []string{constants.FederationSettingsID, IdpID}
+var ListRequiredFields = []string{constants.FederationSettingsID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-federated-settings-identity-provider")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if err := ValidateProtocolFields(currentModel); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	if util.SafeString(currentModel.Protocol) == SAML {
+		return progress_events.GetFailedEventByCode(SAMLCreateNotSupported, string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	idpReq := NewOidcIdentityProviderCreateReq(currentModel)
+	idpResp, apiResp, err := conn.FederatedAuthenticationApi.CreateIdentityProvider(ctx, *federationSettingsID, idpReq).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	resourceModel := GetIdentityProviderModel(NewIdentityProviderFromOidc(idpResp), currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	federationSettingsID := currentModel.FederationSettingsId
+	idpID := currentModel.IdpId
+	idpResp, apiResp, err := conn.FederatedAuthenticationApi.GetIdentityProvider(context.Background(), *federationSettingsID, *idpID).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	resourceModel := GetIdentityProviderModel(idpResp, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if err := ValidateProtocolFields(currentModel); err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	idpID := currentModel.IdpId
+	idpReq := NewIdentityProviderUpdateReq(currentModel)
+	idpResp, apiResp, err := conn.FederatedAuthenticationApi.UpdateIdentityProvider(ctx, *federationSettingsID, *idpID, idpReq).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	resourceModel := GetIdentityProviderModel(idpResp, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	idpID := currentModel.IdpId
+	if apiResp, err := conn.FederatedAuthenticationApi.DeleteIdentityProvider(ctx, *federationSettingsID, *idpID).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	identityProviders, apiResp, err := getAllIdentityProviders(ctx, conn, *federationSettingsID)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	response := make([]interface{}, 0)
+	for i := range identityProviders {
+		model := GetIdentityProviderModel(&identityProviders[i], nil)
+		model.FederationSettingsId = currentModel.FederationSettingsId
+		model.Profile = currentModel.Profile
+
+		response = append(response, model)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  response,
+	}, nil
+}
+
+func getAllIdentityProviders(ctx context.Context, conn *admin.APIClient, federationSettingsID string) ([]admin.FederationIdentityProvider, *http.Response, error) {
+	pageNum := 1
+	accumulatedIdps := make([]admin.FederationIdentityProvider, 0)
+
+	for allRecordsRetrieved := false; !allRecordsRetrieved; {
+		idps, apiResp, err := conn.FederatedAuthenticationApi.ListIdentityProvidersWithParams(ctx, &admin.ListIdentityProvidersApiParams{
+			FederationSettingsId: federationSettingsID,
+			Protocol:             &[]string{SAML, OIDC},
+			IdpType:              &[]string{WorkforceIdpType, WorkloadIdpType},
+			ItemsPerPage:         util.Pointer(constants.DefaultListItemsPerPage),
+			PageNum:              util.Pointer(pageNum),
+		}).Execute()
+
+		if err != nil {
+			return nil, apiResp, err
+		}
+		accumulatedIdps = append(accumulatedIdps, idps.GetResults()...)
+		allRecordsRetrieved = idps.GetTotalCount() <= len(accumulatedIdps)
+		pageNum++
+	}
+
+	return accumulatedIdps, nil, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,17 @@
+#!/usr/bin/env bash
+
+# Run this script with the Makefile
+# make create-test-resources
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+set -o errexit
+set -o nounset
+set -o pipefail
+
+if [ -z "${ATLAS_FEDERATED_SETTINGS_ID+x}" ]; then
+	echo "ATLAS_FEDERATED_SETTINGS_ID must be set"
+	exit 1
+fi
+
+./test/cfn-test-create-inputs.sh
@@ -0,0 +1,64 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+func NewConnectedOrgConfigReq(model *Model) *admin.ConnectedOrgConfig {
+	if model == nil {
+		return nil
+	}
+	return &admin.ConnectedOrgConfig{
+		OrgId:                         util.SafeString(model.OrgId),
+		IdentityProviderId:            model.IdentityProviderId,
+		DataAccessIdentityProviderIds: nonNilSlice(model.DataAccessIdentityProviderIds),
+		DomainRestrictionEnabled:      aws.ToBool(model.DomainRestrictionEnabled),
+		DomainAllowList:               nonNilSlice(model.DomainAllowList),
+		PostAuthRoleGrants:            nonNilSlice(model.PostAuthRoleGrants),
+	}
+}
+
+func GetConnectedOrgConfigModel(orgConfig *admin.ConnectedOrgConfig, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if orgConfig == nil {
+		return model
+	}
+
+	model.OrgId = util.StringPtr(orgConfig.OrgId)
+	model.IdentityProviderId = orgConfig.IdentityProviderId
+	model.DataAccessIdentityProviderIds = orgConfig.GetDataAccessIdentityProviderIds()
+	model.DomainRestrictionEnabled = util.Pointer(orgConfig.DomainRestrictionEnabled)
+	model.DomainAllowList = orgConfig.GetDomainAllowList()
+	model.PostAuthRoleGrants = orgConfig.GetPostAuthRoleGrants()
+	return model
+}
+
+// nonNilSlice always returns a list so that values removed from the template are also removed from the connected org config.
+func nonNilSlice(values []string) *[]string {
+	if values == nil {
+		return &[]string{}
+	}
+	return &values
+}
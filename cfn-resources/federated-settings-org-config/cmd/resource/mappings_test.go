@@ -0,0 +1,125 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/federated-settings-org-config/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	orgID     = "65d6c4f9e3e5f0356a1b2c3d"
+	oktaIdpID = "0oa1b2c3d4e5f6g7h8i9"
+	idpID     = "65d6c4f9e3e5f0356a1b2c3e"
+)
+
+func TestNewConnectedOrgConfigReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin.ConnectedOrgConfig
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "All Fields",
+			input: &resource.Model{
+				OrgId:                         ptr.String(orgID),
+				IdentityProviderId:            ptr.String(oktaIdpID),
+				DataAccessIdentityProviderIds: []string{idpID},
+				DomainRestrictionEnabled:      ptr.Bool(true),
+				DomainAllowList:               []string{"example.com"},
+				PostAuthRoleGrants:            []string{"ORG_MEMBER"},
+			},
+			expected: &admin.ConnectedOrgConfig{
+				OrgId:                         orgID,
+				IdentityProviderId:            ptr.String(oktaIdpID),
+				DataAccessIdentityProviderIds: &[]string{idpID},
+				DomainRestrictionEnabled:      true,
+				DomainAllowList:               &[]string{"example.com"},
+				PostAuthRoleGrants:            &[]string{"ORG_MEMBER"},
+			},
+		},
+		{
+			name: "Unset lists are sent empty",
+			input: &resource.Model{
+				OrgId:                    ptr.String(orgID),
+				DomainRestrictionEnabled: ptr.Bool(false),
+			},
+			expected: &admin.ConnectedOrgConfig{
+				OrgId:                         orgID,
+				DataAccessIdentityProviderIds: &[]string{},
+				DomainRestrictionEnabled:      false,
+				DomainAllowList:               &[]string{},
+				PostAuthRoleGrants:            &[]string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewConnectedOrgConfigReq(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetConnectedOrgConfigModel(t *testing.T) {
+	tests := []struct {
+		input    *admin.ConnectedOrgConfig
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "All Fields",
+			input: &admin.ConnectedOrgConfig{
+				OrgId:                         orgID,
+				IdentityProviderId:            ptr.String(oktaIdpID),
+				DataAccessIdentityProviderIds: &[]string{idpID},
+				DomainRestrictionEnabled:      true,
+				DomainAllowList:               &[]string{"example.com"},
+				PostAuthRoleGrants:            &[]string{"ORG_MEMBER"},
+			},
+			expected: &resource.Model{
+				OrgId:                         ptr.String(orgID),
+				IdentityProviderId:            ptr.String(oktaIdpID),
+				DataAccessIdentityProviderIds: []string{idpID},
+				DomainRestrictionEnabled:      ptr.Bool(true),
+				DomainAllowList:               []string{"example.com"},
+				PostAuthRoleGrants:            []string{"ORG_MEMBER"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetConnectedOrgConfigModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
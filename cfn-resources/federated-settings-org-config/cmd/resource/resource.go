@@ -0,0 +1,207 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var CreateRequiredFields = []string{constants.FederationSettingsID, constants.OrgID, "DomainRestrictionEnabled"}
+var ReadRequiredFields = []string{constants.FederationSettingsID, constants.OrgID}
+var UpdateRequiredFields = []string{constants.FederationSettingsID, constants.OrgID, "DomainRestrictionEnabled"}
+var DeleteRequiredFields = []string{constants.FederationSettingsID, constants.OrgID}
+var ListRequiredFields = []string{constants.FederationSettingsID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-federated-settings-org-config")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	orgID := currentModel.OrgId
+	if _, _, err := conn.FederatedAuthenticationApi.GetConnectedOrgConfig(ctx, *federationSettingsID, *orgID).Execute(); err == nil {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("organization %s is already connected to federation %s", *orgID, *federationSettingsID),
+			string(types.HandlerErrorCodeAlreadyExists)), nil
+	}
+
+	orgConfigResp, apiResp, err := conn.FederatedAuthenticationApi.UpdateConnectedOrgConfig(ctx, *federationSettingsID, *orgID, NewConnectedOrgConfigReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	resourceModel := GetConnectedOrgConfigModel(orgConfigResp, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	federationSettingsID := currentModel.FederationSettingsId
+	orgID := currentModel.OrgId
+	orgConfigResp, apiResp, err := conn.FederatedAuthenticationApi.GetConnectedOrgConfig(context.Background(), *federationSettingsID, *orgID).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	resourceModel := GetConnectedOrgConfigModel(orgConfigResp, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	orgID := currentModel.OrgId
+	if _, apiResp, err := conn.FederatedAuthenticationApi.GetConnectedOrgConfig(ctx, *federationSettingsID, *orgID).Execute(); err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	orgConfigResp, apiResp, err := conn.FederatedAuthenticationApi.UpdateConnectedOrgConfig(ctx, *federationSettingsID, *orgID, NewConnectedOrgConfigReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	resourceModel := GetConnectedOrgConfigModel(orgConfigResp, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	orgID := currentModel.OrgId
+	if apiResp, err := conn.FederatedAuthenticationApi.RemoveConnectedOrgConfig(ctx, *federationSettingsID, *orgID).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+
+	federationSettingsID := currentModel.FederationSettingsId
+	orgConfigs, apiResp, err := getAllConnectedOrgConfigs(ctx, conn, *federationSettingsID)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	response := make([]interface{}, 0)
+	for i := range orgConfigs {
+		model := GetConnectedOrgConfigModel(&orgConfigs[i], nil)
+		model.FederationSettingsId = currentModel.FederationSettingsId
+		model.Profile = currentModel.Profile
+
+		response = append(response, model)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  response,
+	}, nil
+}
+
+func getAllConnectedOrgConfigs(ctx context.Context, conn *admin.APIClient, federationSettingsID string) ([]admin.ConnectedOrgConfig, *http.Response, error) {
+	pageNum := 1
+	accumulatedOrgConfigs := make([]admin.ConnectedOrgConfig, 0)
+
+	for allRecordsRetrieved := false; !allRecordsRetrieved; {
+		orgConfigs, apiResp, err := conn.FederatedAuthenticationApi.ListConnectedOrgConfigsWithParams(ctx, &admin.ListConnectedOrgConfigsApiParams{
+			FederationSettingsId: federationSettingsID,
+			ItemsPerPage:         util.Pointer(constants.DefaultListItemsPerPage),
+			PageNum:              util.Pointer(pageNum),
+		}).Execute()
+
+		if err != nil {
+			return nil, apiResp, err
+		}
+		accumulatedOrgConfigs = append(accumulatedOrgConfigs, orgConfigs.GetResults()...)
+		allRecordsRetrieved = orgConfigs.GetTotalCount() <= len(accumulatedOrgConfigs)
+		pageNum++
+	}
+
+	return accumulatedOrgConfigs, nil, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
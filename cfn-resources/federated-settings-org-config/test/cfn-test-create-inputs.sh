@@ -0,0 +1,42 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+# NOTE: You need to set the Federation Settings Id in order to execute this resource.
+#       You can get the Federation Settings Id on Atlas UI under the 'Manage Federation Settings' console
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="federation"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+federationSettingsId="${ATLAS_FEDERATED_SETTINGS_ID}"
+orgId="${MONGODB_ATLAS_ORG_ID}"
+
+WORDTOREMOVE="template."
+
+cd "$(dirname "$0")" || exit
+for inputFile in inputs_*; do
+	outputFile=${inputFile//$WORDTOREMOVE/}
+	jq --arg FederationSettingsId "$federationSettingsId" \
+		--arg OrgId "$orgId" \
+		--arg profile "$profile" \
+		'.Profile?|=$profile | .FederationSettingsId?|=$FederationSettingsId
+		| .OrgId?|=$OrgId' \
+		"$inputFile" >"../inputs/$outputFile"
+done
+
+cd ..
+
+ls -l inputs
@@ -0,0 +1,152 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const (
+	NewStatus      = "NEW"
+	WorkingStatus  = "WORKING"
+	FailedStatus   = "FAILED"
+	CompleteStatus = "COMPLETE"
+	ExpiredStatus  = "EXPIRED"
+
+	ValidationPending = "PENDING"
+	ValidationSuccess = "SUCCESS"
+	ValidationFailed  = "FAILED"
+)
+
+// NewLiveMigrationReq builds the request used both to validate and to start the migration.
+func NewLiveMigrationReq(model *Model) (*admin.LiveMigrationRequest20240530, error) {
+	if model == nil {
+		return nil, nil
+	}
+	migrationReq := &admin.LiveMigrationRequest20240530{
+		Destination:         newDestination(model.Destination, util.SafeString(model.ProjectId)),
+		Source:              newSource(model.Source),
+		DropDestinationData: model.DropDestinationData,
+	}
+	if len(model.MigrationHosts) > 0 {
+		migrationReq.MigrationHosts = &model.MigrationHosts
+	}
+	sharding, err := newShardingRequest(model.Sharding)
+	if err != nil {
+		return nil, err
+	}
+	migrationReq.Sharding = sharding
+	return migrationReq, nil
+}
+
+func newSource(source *Source) admin.Source {
+	if source == nil {
+		return admin.Source{}
+	}
+	return admin.Source{
+		ClusterName:           util.SafeString(source.ClusterName),
+		GroupId:               util.SafeString(source.GroupId),
+		ManagedAuthentication: aws.ToBool(source.ManagedAuthentication),
+		Ssl:                   aws.ToBool(source.Ssl),
+		Username:              source.Username,
+		Password:              source.Password,
+		CaCertificatePath:     source.CaCertificatePath,
+	}
+}
+
+func newDestination(destination *Destination, projectID string) admin.Destination {
+	if destination == nil {
+		return admin.Destination{GroupId: projectID}
+	}
+	return admin.Destination{
+		ClusterName:        util.SafeString(destination.ClusterName),
+		GroupId:            projectID,
+		HostnameSchemaType: util.SafeString(destination.HostnameSchemaType),
+		PrivateLinkId:      destination.PrivateLinkId,
+	}
+}
+
+func newShardingRequest(sharding *Sharding) (*admin.ShardingRequest, error) {
+	if sharding == nil {
+		return nil, nil
+	}
+	shardingReq := &admin.ShardingRequest{
+		CreateSupportingIndexes: aws.ToBool(sharding.CreateSupportingIndexes),
+	}
+	if len(sharding.ShardingEntries) == 0 {
+		return shardingReq, nil
+	}
+	entries := make([]admin.ShardEntry, 0, len(sharding.ShardingEntries))
+	for i := range sharding.ShardingEntries {
+		entry := sharding.ShardingEntries[i]
+		key, err := ParseShardKey(entry.ShardKey)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, admin.ShardEntry{
+			Database:        util.SafeString(entry.Database),
+			Collection:      util.SafeString(entry.Collection),
+			ShardCollection: admin.ShardKeys{Key: &key},
+		})
+	}
+	shardingReq.ShardingEntries = &entries
+	return shardingReq, nil
+}
+
+// ParseShardKey converts the stringified ShardKey of a sharding entry into the list of key documents expected by the Atlas Admin API.
+func ParseShardKey(shardKey *string) ([]any, error) {
+	if !util.IsStringPresent(shardKey) {
+		return nil, fmt.Errorf("invalid ShardKey, must be set for every sharding entry")
+	}
+	key := make([]any, 0)
+	if err := json.Unmarshal([]byte(*shardKey), &key); err != nil {
+		return nil, fmt.Errorf("invalid ShardKey, must be a json array of documents: %w", err)
+	}
+	return key, nil
+}
+
+func GetLiveMigrationModel(migration *admin.LiveMigrationResponse, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if migration == nil {
+		return model
+	}
+
+	model.MigrationId = migration.Id
+	model.Status = migration.Status
+	model.ReadyForCutover = migration.ReadyForCutover
+	model.LagTimeSeconds = int64PtrToIntPtr(migration.LagTimeSeconds)
+	if migration.MigrationHosts != nil {
+		model.MigrationHosts = migration.GetMigrationHosts()
+	}
+	return model
+}
+
+func int64PtrToIntPtr(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+	return util.Pointer(int(*value))
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/live-migration/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	projectID       = "111111111111111111111111"
+	sourceProjectID = "222222222222222222222222"
+	migrationID     = "333333333333333333333333"
+)
+
+func TestNewLiveMigrationReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin.LiveMigrationRequest20240530
+		name     string
+		wantErr  bool
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "Replica set migration",
+			input: &resource.Model{
+				ProjectId: ptr.String(projectID),
+				Source: &resource.Source{
+					ClusterName:           ptr.String("source"),
+					GroupId:               ptr.String(sourceProjectID),
+					ManagedAuthentication: ptr.Bool(false),
+					Ssl:                   ptr.Bool(true),
+					Username:              ptr.String("user"),
+					Password:              ptr.String("password"),
+				},
+				Destination: &resource.Destination{
+					ClusterName:        ptr.String("destination"),
+					HostnameSchemaType: ptr.String("PUBLIC"),
+				},
+				DropDestinationData: ptr.Bool(true),
+				MigrationHosts:      []string{"host.example.com"},
+			},
+			expected: &admin.LiveMigrationRequest20240530{
+				Source: admin.Source{
+					ClusterName: "source",
+					GroupId:     sourceProjectID,
+					Ssl:         true,
+					Username:    ptr.String("user"),
+					Password:    ptr.String("password"),
+				},
+				Destination: admin.Destination{
+					ClusterName:        "destination",
+					GroupId:            projectID,
+					HostnameSchemaType: "PUBLIC",
+				},
+				DropDestinationData: ptr.Bool(true),
+				MigrationHosts:      &[]string{"host.example.com"},
+			},
+		},
+		{
+			name: "Sharded migration",
+			input: &resource.Model{
+				ProjectId: ptr.String(projectID),
+				Source: &resource.Source{
+					ClusterName:           ptr.String("source"),
+					GroupId:               ptr.String(sourceProjectID),
+					ManagedAuthentication: ptr.Bool(true),
+					Ssl:                   ptr.Bool(false),
+				},
+				Destination: &resource.Destination{
+					ClusterName:        ptr.String("destination"),
+					HostnameSchemaType: ptr.String("PUBLIC"),
+				},
+				Sharding: &resource.Sharding{
+					CreateSupportingIndexes: ptr.Bool(true),
+					ShardingEntries: []resource.ShardEntry{
+						{
+							Database:   ptr.String("db"),
+							Collection: ptr.String("coll"),
+							ShardKey:   ptr.String(`[{"location": 1}]`),
+						},
+					},
+				},
+			},
+			expected: &admin.LiveMigrationRequest20240530{
+				Source: admin.Source{
+					ClusterName:           "source",
+					GroupId:               sourceProjectID,
+					ManagedAuthentication: true,
+				},
+				Destination: admin.Destination{
+					ClusterName:        "destination",
+					GroupId:            projectID,
+					HostnameSchemaType: "PUBLIC",
+				},
+				Sharding: &admin.ShardingRequest{
+					CreateSupportingIndexes: true,
+					ShardingEntries: &[]admin.ShardEntry{
+						{
+							Database:        "db",
+							Collection:      "coll",
+							ShardCollection: admin.ShardKeys{Key: &[]any{map[string]any{"location": float64(1)}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid shard key",
+			input: &resource.Model{
+				ProjectId: ptr.String(projectID),
+				Sharding: &resource.Sharding{
+					CreateSupportingIndexes: ptr.Bool(true),
+					ShardingEntries: []resource.ShardEntry{
+						{
+							Database:   ptr.String("db"),
+							Collection: ptr.String("coll"),
+							ShardKey:   ptr.String(`{"location": 1}`),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := resource.NewLiveMigrationReq(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetLiveMigrationModel(t *testing.T) {
+	tests := []struct {
+		input    *admin.LiveMigrationResponse
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "Migration in progress",
+			input: &admin.LiveMigrationResponse{
+				Id:              ptr.String(migrationID),
+				Status:          ptr.String(resource.WorkingStatus),
+				LagTimeSeconds:  util.Pointer(int64(42)),
+				ReadyForCutover: ptr.Bool(true),
+				MigrationHosts:  &[]string{"host.example.com"},
+			},
+			expected: &resource.Model{
+				MigrationId:     ptr.String(migrationID),
+				Status:          ptr.String(resource.WorkingStatus),
+				LagTimeSeconds:  util.Pointer(42),
+				ReadyForCutover: ptr.Bool(true),
+				MigrationHosts:  []string{"host.example.com"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetLiveMigrationModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
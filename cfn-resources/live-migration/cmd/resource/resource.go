@@ -0,0 +1,279 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	MigrationID = "MigrationId"
+
+	callBackSeconds      = 30
+	callbackStage        = "callbackLiveMigrationStage"
+	callbackValidationID = "callbackLiveMigrationValidationId"
+
+	validatingStage  = "VALIDATING"
+	migratingStage   = "MIGRATING"
+	cuttingOverStage = "CUTTING_OVER"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, "Source.ClusterName", "Source.GroupId", "Destination.ClusterName", "Destination.HostnameSchemaType"}
+var ReadRequiredFields = []string{constants.ProjectID, MigrationID}
+var UpdateRequiredFields = []string{constants.ProjectID, MigrationID}
+var DeleteRequiredFields = []string{constants.ProjectID, MigrationID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-live-migration")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create validates the migration, starts it once the validation succeeds and waits until the migration is ready for
+// cutover. When Cutover is true the cutover is performed as well and Create waits until the migration is complete.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if stage, isCallback := req.CallbackContext[callbackStage]; isCallback {
+		return validateProgress(conn, currentModel, stage.(string), req.CallbackContext, constants.CREATE)
+	}
+
+	migrationReq, err := NewLiveMigrationReq(currentModel)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	validation, apiResp, err := conn.CloudMigrationServiceApi.ValidateLiveMigrations(context.Background(), *currentModel.ProjectId, migrationReq).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return inProgressEvent(currentModel, map[string]any{
+		callbackStage:        validatingStage,
+		callbackValidationID: validation.GetId(),
+	}), nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	migration, apiResp, err := getLiveMigration(conn, currentModel)
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   GetLiveMigrationModel(migration, currentModel),
+	}, nil
+}
+
+// Update performs the cutover of the migration when Cutover changes to true. All the other properties are create only.
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if stage, isCallback := req.CallbackContext[callbackStage]; isCallback {
+		return validateProgress(conn, currentModel, stage.(string), req.CallbackContext, constants.UPDATE)
+	}
+
+	if prevModel != nil && aws.ToBool(prevModel.Cutover) && !aws.ToBool(currentModel.Cutover) {
+		return progress_events.GetFailedEventByCode("Cutover can't be reverted once it has been performed",
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	migration, apiResp, err := getLiveMigration(conn, currentModel)
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	if aws.ToBool(currentModel.Cutover) && migration.GetStatus() != CompleteStatus {
+		return progressMigration(conn, currentModel, migration, constants.UPDATE)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetLiveMigrationModel(migration, currentModel),
+	}, nil
+}
+
+// Delete only removes the migration from the stack. The Atlas Admin API doesn't allow cancelling a live migration,
+// a migration that hasn't been cut over must be cancelled in the Atlas UI.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if _, apiResp, err := getLiveMigration(conn, currentModel); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	return handler.ProgressEvent{}, errors.New("not implemented: List")
+}
+
+func validateProgress(conn *admin.APIClient, currentModel *Model, stage string, callbackContext map[string]any, method constants.CfnFunctions) (handler.ProgressEvent, error) {
+	if stage == validatingStage {
+		validationID, _ := callbackContext[callbackValidationID].(string)
+		return validateMigration(conn, currentModel, validationID, method)
+	}
+
+	migration, apiResp, err := getLiveMigration(conn, currentModel)
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	if stage == cuttingOverStage {
+		return progressCutover(currentModel, migration)
+	}
+	return progressMigration(conn, currentModel, migration, method)
+}
+
+// validateMigration waits for the validation job to finish and starts the migration once it succeeds.
+func validateMigration(conn *admin.APIClient, currentModel *Model, validationID string, method constants.CfnFunctions) (handler.ProgressEvent, error) {
+	ctx := context.Background()
+	projectID := currentModel.ProjectId
+	validation, apiResp, err := conn.CloudMigrationServiceApi.GetMigrationValidateStatus(ctx, *projectID, validationID).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	switch validation.GetStatus() {
+	case ValidationSuccess:
+		migrationReq, err := NewLiveMigrationReq(currentModel)
+		if err != nil {
+			return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+		}
+		migration, apiResp, err := conn.CloudMigrationServiceApi.CreateGroupLiveMigration(ctx, *projectID, migrationReq).Execute()
+		if err != nil {
+			return handleError(apiResp, method, err)
+		}
+		return inProgressEvent(GetLiveMigrationModel(migration, currentModel), map[string]any{callbackStage: migratingStage}), nil
+	case ValidationFailed:
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("live migration validation failed: %s", validation.GetErrorMessage()),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	default:
+		return inProgressEvent(currentModel, map[string]any{
+			callbackStage:        validatingStage,
+			callbackValidationID: validationID,
+		}), nil
+	}
+}
+
+// progressMigration waits until the migration is ready for cutover and performs the cutover when Cutover is true.
+func progressMigration(conn *admin.APIClient, currentModel *Model, migration *admin.LiveMigrationResponse, method constants.CfnFunctions) (handler.ProgressEvent, error) {
+	resourceModel := GetLiveMigrationModel(migration, currentModel)
+
+	switch migration.GetStatus() {
+	case FailedStatus, ExpiredStatus:
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("live migration %s is %s", migration.GetId(), migration.GetStatus()),
+			string(types.HandlerErrorCodeGeneralServiceException)), nil
+	case CompleteStatus:
+		return successEvent(resourceModel), nil
+	}
+
+	if !migration.GetReadyForCutover() {
+		return inProgressEvent(resourceModel, map[string]any{callbackStage: migratingStage}), nil
+	}
+	if !aws.ToBool(currentModel.Cutover) {
+		return successEvent(resourceModel), nil
+	}
+
+	if apiResp, err := conn.CloudMigrationServiceApi.CutoverMigration(context.Background(), *currentModel.ProjectId, migration.GetId()).Execute(); err != nil {
+		return handleError(apiResp, method, err)
+	}
+	return inProgressEvent(resourceModel, map[string]any{callbackStage: cuttingOverStage}), nil
+}
+
+func progressCutover(currentModel *Model, migration *admin.LiveMigrationResponse) (handler.ProgressEvent, error) {
+	resourceModel := GetLiveMigrationModel(migration, currentModel)
+
+	switch migration.GetStatus() {
+	case CompleteStatus:
+		return successEvent(resourceModel), nil
+	case FailedStatus, ExpiredStatus:
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("cutover of live migration %s failed, status is %s", migration.GetId(), migration.GetStatus()),
+			string(types.HandlerErrorCodeGeneralServiceException)), nil
+	default:
+		return inProgressEvent(resourceModel, map[string]any{callbackStage: cuttingOverStage}), nil
+	}
+}
+
+func getLiveMigration(conn *admin.APIClient, currentModel *Model) (*admin.LiveMigrationResponse, *http.Response, error) {
+	return conn.CloudMigrationServiceApi.GetGroupLiveMigration(context.Background(), *currentModel.ProjectId, *currentModel.MigrationId).Execute()
+}
+
+func successEvent(model *Model) handler.ProgressEvent {
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.Complete,
+		ResourceModel:   model,
+	}
+}
+
+func inProgressEvent(model *Model, callbackContext map[string]any) handler.ProgressEvent {
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              constants.Pending,
+		ResourceModel:        model,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext:      callbackContext,
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// ShouldDefer returns true when Defer changes from false or unset to true. Defer is an action that postpones
+// the scheduled maintenance by one week, so it's only sent once instead of on every update that keeps it set.
+func ShouldDefer(prevModel, currentModel *Model) bool {
+	if currentModel == nil || !aws.ToBool(currentModel.Defer) {
+		return false
+	}
+	return prevModel == nil || !aws.ToBool(prevModel.Defer)
+}
+
+func (m *Model) ToAtlasModel() admin.GroupMaintenanceWindow {
+	maintenanceWindow := admin.GroupMaintenanceWindow{
+		HourOfDay:            m.HourOfDay,
+		StartASAP:            m.StartASAP,
+		AutoDeferOnceEnabled: m.AutoDeferOnceEnabled,
+	}
+	if m.DayOfWeek != nil {
+		maintenanceWindow.DayOfWeek = *m.DayOfWeek
+	}
+	if m.ProtectedHours != nil {
+		maintenanceWindow.ProtectedHours = &admin.ProtectedHours{
+			StartHourOfDay: m.ProtectedHours.StartHourOfDay,
+			EndHourOfDay:   m.ProtectedHours.EndHourOfDay,
+		}
+	}
+	return maintenanceWindow
+}
+
+func (m *Model) SetModel(maintenanceWindow *admin.GroupMaintenanceWindow) {
+	m.AutoDeferOnceEnabled = maintenanceWindow.AutoDeferOnceEnabled
+	m.DayOfWeek = &maintenanceWindow.DayOfWeek
+	m.HourOfDay = maintenanceWindow.HourOfDay
+	m.NumberOfDeferrals = maintenanceWindow.NumberOfDeferrals
+	m.TimeZoneId = maintenanceWindow.TimeZoneId
+	m.ProtectedHours = nil
+	if protectedHours := maintenanceWindow.ProtectedHours; protectedHours != nil &&
+		(protectedHours.StartHourOfDay != nil || protectedHours.EndHourOfDay != nil) {
+		m.ProtectedHours = &ProtectedHours{
+			StartHourOfDay: protectedHours.StartHourOfDay,
+			EndHourOfDay:   protectedHours.EndHourOfDay,
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/maintenance-window/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldDefer(t *testing.T) {
+	tests := []struct {
+		prevModel    *resource.Model
+		currentModel *resource.Model
+		name         string
+		expected     bool
+	}{
+		{
+			name:         "Defer Not Set",
+			prevModel:    &resource.Model{},
+			currentModel: &resource.Model{},
+			expected:     false,
+		},
+		{
+			name:         "Defer Unset To True",
+			prevModel:    &resource.Model{},
+			currentModel: &resource.Model{Defer: ptr.Bool(true)},
+			expected:     true,
+		},
+		{
+			name:         "Defer False To True",
+			prevModel:    &resource.Model{Defer: ptr.Bool(false)},
+			currentModel: &resource.Model{Defer: ptr.Bool(true)},
+			expected:     true,
+		},
+		{
+			name:         "Nil Previous Model",
+			currentModel: &resource.Model{Defer: ptr.Bool(true)},
+			expected:     true,
+		},
+		{
+			name:         "Defer Stays True",
+			prevModel:    &resource.Model{Defer: ptr.Bool(true)},
+			currentModel: &resource.Model{Defer: ptr.Bool(true), HourOfDay: ptr.Int(5)},
+			expected:     false,
+		},
+		{
+			name:         "Defer True To False",
+			prevModel:    &resource.Model{Defer: ptr.Bool(true)},
+			currentModel: &resource.Model{Defer: ptr.Bool(false)},
+			expected:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.ShouldDefer(tt.prevModel, tt.currentModel))
+		})
+	}
+}
+
+func TestToAtlasModel(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected admin.GroupMaintenanceWindow
+		name     string
+	}{
+		{
+			name:     "Empty Model",
+			input:    &resource.Model{},
+			expected: admin.GroupMaintenanceWindow{},
+		},
+		{
+			name: "Full Model",
+			input: &resource.Model{
+				DayOfWeek:            ptr.Int(2),
+				HourOfDay:            ptr.Int(4),
+				StartASAP:            ptr.Bool(false),
+				AutoDeferOnceEnabled: ptr.Bool(true),
+				Defer:                ptr.Bool(true),
+				ProtectedHours: &resource.ProtectedHours{
+					StartHourOfDay: ptr.Int(9),
+					EndHourOfDay:   ptr.Int(17),
+				},
+			},
+			expected: admin.GroupMaintenanceWindow{
+				DayOfWeek:            2,
+				HourOfDay:            ptr.Int(4),
+				StartASAP:            ptr.Bool(false),
+				AutoDeferOnceEnabled: ptr.Bool(true),
+				ProtectedHours: &admin.ProtectedHours{
+					StartHourOfDay: ptr.Int(9),
+					EndHourOfDay:   ptr.Int(17),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.input.ToAtlasModel())
+		})
+	}
+}
+
+func TestSetModel(t *testing.T) {
+	tests := []struct {
+		input        *admin.GroupMaintenanceWindow
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name: "Without Protected Hours",
+			input: &admin.GroupMaintenanceWindow{
+				DayOfWeek:         3,
+				HourOfDay:         ptr.Int(1),
+				NumberOfDeferrals: ptr.Int(0),
+				TimeZoneId:        ptr.String("UTC"),
+				ProtectedHours:    &admin.ProtectedHours{},
+			},
+			currentModel: &resource.Model{
+				ProjectId:      ptr.String("projectId"),
+				ProtectedHours: &resource.ProtectedHours{StartHourOfDay: ptr.Int(9)},
+			},
+			expected: &resource.Model{
+				ProjectId:         ptr.String("projectId"),
+				DayOfWeek:         ptr.Int(3),
+				HourOfDay:         ptr.Int(1),
+				NumberOfDeferrals: ptr.Int(0),
+				TimeZoneId:        ptr.String("UTC"),
+			},
+		},
+		{
+			name: "With Protected Hours",
+			input: &admin.GroupMaintenanceWindow{
+				DayOfWeek:            7,
+				HourOfDay:            ptr.Int(23),
+				AutoDeferOnceEnabled: ptr.Bool(true),
+				NumberOfDeferrals:    ptr.Int(1),
+				ProtectedHours: &admin.ProtectedHours{
+					StartHourOfDay: ptr.Int(9),
+					EndHourOfDay:   ptr.Int(17),
+				},
+			},
+			currentModel: &resource.Model{
+				ProjectId: ptr.String("projectId"),
+				Defer:     ptr.Bool(true),
+			},
+			expected: &resource.Model{
+				ProjectId:            ptr.String("projectId"),
+				Defer:                ptr.Bool(true),
+				DayOfWeek:            ptr.Int(7),
+				HourOfDay:            ptr.Int(23),
+				AutoDeferOnceEnabled: ptr.Bool(true),
+				NumberOfDeferrals:    ptr.Int(1),
+				ProtectedHours: &resource.ProtectedHours{
+					StartHourOfDay: ptr.Int(9),
+					EndHourOfDay:   ptr.Int(17),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.currentModel.SetModel(tt.input)
+			assert.Equal(t, tt.expected, tt.currentModel)
+		})
+	}
+}
@@ -4,10 +4,20 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	Profile              *string `json:",omitempty"`
-	AutoDeferOnceEnabled *bool   `json:",omitempty"`
-	DayOfWeek            *int    `json:",omitempty"`
-	ProjectId            *string `json:",omitempty"`
-	HourOfDay            *int    `json:",omitempty"`
-	StartASAP            *bool   `json:",omitempty"`
+	Profile              *string         `json:",omitempty"`
+	AutoDeferOnceEnabled *bool           `json:",omitempty"`
+	DayOfWeek            *int            `json:",omitempty"`
+	ProjectId            *string         `json:",omitempty"`
+	HourOfDay            *int            `json:",omitempty"`
+	StartASAP            *bool           `json:",omitempty"`
+	ProtectedHours       *ProtectedHours `json:",omitempty"`
+	Defer                *bool           `json:",omitempty"`
+	NumberOfDeferrals    *int            `json:",omitempty"`
+	TimeZoneId           *string         `json:",omitempty"`
+}
+
+// ProtectedHours is autogenerated from the json schema
+type ProtectedHours struct {
+	StartHourOfDay *int `json:",omitempty"`
+	EndHourOfDay   *int `json:",omitempty"`
 }
@@ -18,16 +18,18 @@ import (
 	"context"
 	"errors"
 
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
-	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 var RequiredFields = []string{constants.ProjectID}
@@ -56,11 +58,11 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return progress_events.GetFailedEventByCode("resource already exists", string(types.HandlerErrorCodeAlreadyExists)), nil
 	}
 
-	atlasModel := currentModel.toAtlasModel()
+	atlasModel := currentModel.ToAtlasModel()
 	startASP := false
 	atlasModel.StartASAP = &startASP
 
-	_, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
+	resp, err := client.AtlasSDK.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
 	if err != nil {
 		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -90,9 +92,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *errorProgressEvent, nil
 	}
 
-	currentModel.AutoDeferOnceEnabled = maintenanceWindow.AutoDeferOnceEnabled
-	currentModel.DayOfWeek = &maintenanceWindow.DayOfWeek
-	currentModel.HourOfDay = &maintenanceWindow.HourOfDay
+	currentModel.SetModel(maintenanceWindow)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -120,15 +120,22 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *handlerError, nil
 	}
 
-	atlasModel := currentModel.toAtlasModel()
+	atlasModel := currentModel.ToAtlasModel()
 	startASP := false
 	atlasModel.StartASAP = &startASP
 
-	_, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
+	resp, err := client.AtlasSDK.MaintenanceWindowsApi.UpdateMaintenanceWindow(context.Background(), *currentModel.ProjectId, &atlasModel).Execute()
 	if err != nil {
 		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
+	if ShouldDefer(prevModel, currentModel) {
+		resp, err = client.AtlasSDK.MaintenanceWindowsApi.DeferMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
+		if err != nil {
+			return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
+		}
+	}
+
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		ResourceModel:   currentModel,
@@ -154,7 +161,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *handlerError, nil
 	}
 
-	resp, err := client.Atlas20231115002.MaintenanceWindowsApi.ResetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
+	resp, err := client.AtlasSDK.MaintenanceWindowsApi.ResetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		return progress_events.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -169,17 +176,8 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	return handler.ProgressEvent{}, errors.New("not implemented: List")
 }
 
-func (m Model) toAtlasModel() admin20231115002.GroupMaintenanceWindow {
-	return admin20231115002.GroupMaintenanceWindow{
-		DayOfWeek:            *m.DayOfWeek,
-		HourOfDay:            *m.HourOfDay,
-		StartASAP:            m.StartASAP,
-		AutoDeferOnceEnabled: m.AutoDeferOnceEnabled,
-	}
-}
-
-func get(client *util.MongoDBClient, currentModel Model) (*admin20231115002.GroupMaintenanceWindow, *handler.ProgressEvent) {
-	maintenanceWindow, resp, err := client.Atlas20231115002.MaintenanceWindowsApi.GetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
+func get(client *util.MongoDBClient, currentModel Model) (*admin.GroupMaintenanceWindow, *handler.ProgressEvent) {
+	maintenanceWindow, resp, err := client.AtlasSDK.MaintenanceWindowsApi.GetMaintenanceWindow(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
 		_, _ = logger.Warnf("Read - error: %+v", err)
 		ev := progress_events.GetFailedEventByResponse(err.Error(), resp)
@@ -195,6 +193,6 @@ func get(client *util.MongoDBClient, currentModel Model) (*admin20231115002.Grou
 	return maintenanceWindow, nil
 }
 
-func isResponseEmpty(maintenanceWindow *admin20231115002.GroupMaintenanceWindow) bool {
+func isResponseEmpty(maintenanceWindow *admin.GroupMaintenanceWindow) bool {
 	return maintenanceWindow != nil && maintenanceWindow.DayOfWeek == 0
 }
@@ -30,7 +30,7 @@ import (
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
 )
 
-var createRequiredFields = []string{constants.ProjectID, constants.RegionName, constants.AtlasCIDRBlock}
+var createRequiredFields = []string{constants.ProjectID, constants.AtlasCIDRBlock}
 
 func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	setup()
@@ -48,11 +48,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *peErr, nil
 	}
 
-	containerRequest := &admin20231115002.CloudProviderContainer{
-		ProviderName:   admin20231115002.PtrString(constants.AWS),
-		RegionName:     currentModel.RegionName,
-		AtlasCidrBlock: currentModel.AtlasCidrBlock,
-	}
+	containerRequest := NewCloudProviderContainerReq(currentModel)
 
 	containerID, err := createContainer(client, *currentModel.ProjectId, containerRequest)
 	if err != nil {
@@ -94,7 +90,7 @@ func createContainer(client *util.MongoDBClient, projectID string, request *admi
 	}
 
 	for i := range containers.Results {
-		if containers.Results[i].RegionName == request.RegionName {
+		if isContainerRegion(&containers.Results[i], request) {
 			return *containers.Results[i].Id, nil
 		}
 	}
@@ -107,10 +103,6 @@ func validateCreateModel(fields []string, model *Model) error {
 		return fmt.Errorf("error creating network container: `%s` must be set", constants.ProjectID)
 	}
 
-	if !util.IsStringPresent(model.RegionName) {
-		return fmt.Errorf("`error creating network container: `%s` must be set", constants.RegionName)
-	}
-
 	if !util.IsStringPresent(model.AtlasCidrBlock) {
 		return fmt.Errorf("error creating network container: `%s` must be set", constants.AtlasCIDRBlock)
 	}
@@ -119,5 +111,9 @@ func validateCreateModel(fields []string, model *Model) error {
 		return errors.New(event.Message)
 	}
 
+	if err := ValidateProviderFields(model); err != nil {
+		return err
+	}
+
 	return nil
 }
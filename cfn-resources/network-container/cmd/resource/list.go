@@ -44,9 +44,8 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *peErr, nil
 	}
 
-	aws := constants.AWS
 	containerRequest := &admin20231115002.ListPeeringContainerByCloudProviderApiParams{
-		ProviderName: &aws,
+		ProviderName: admin20231115002.PtrString(GetCloudProvider(currentModel)),
 		GroupId:      *currentModel.ProjectId,
 	}
 	_, _ = logger.Debugf("List - containerRequest:%v", containerRequest)
@@ -72,13 +71,8 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 }
 
 func completeByConnection(c *admin20231115002.CloudProviderContainer, projectID, profileName string) Model {
-	return Model{
-		RegionName:     c.RegionName,
-		Provisioned:    c.Provisioned,
-		Id:             c.Id,
-		VpcId:          c.VpcId,
-		AtlasCidrBlock: c.AtlasCidrBlock,
-		ProjectId:      &projectID,
-		Profile:        &profileName,
-	}
+	model := GetNetworkContainerModel(c, nil)
+	model.ProjectId = &projectID
+	model.Profile = &profileName
+	return *model
 }
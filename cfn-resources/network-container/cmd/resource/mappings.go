@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var requiredPerProvider = map[string][]string{
+	constants.AWS:   {constants.RegionName},
+	constants.AZURE: {constants.Region},
+	constants.GCP:   {},
+}
+
+// GetCloudProvider returns the CloudProvider of the model, AWS when it is not set.
+func GetCloudProvider(model *Model) string {
+	if util.IsStringPresent(model.CloudProvider) {
+		return *model.CloudProvider
+	}
+	return constants.AWS
+}
+
+// ValidateProviderFields checks that the region properties required by the CloudProvider of the container are set.
+func ValidateProviderFields(model *Model) error {
+	provider := GetCloudProvider(model)
+	requiredFields, ok := requiredPerProvider[provider]
+	if !ok {
+		return fmt.Errorf("error creating network container: unsupported CloudProvider %s", provider)
+	}
+	if event := validator.ValidateModel(requiredFields, model); event != nil {
+		return errors.New(event.Message)
+	}
+	return nil
+}
+
+func NewCloudProviderContainerReq(model *Model) *admin20231115002.CloudProviderContainer {
+	if model == nil {
+		return nil
+	}
+	provider := GetCloudProvider(model)
+	containerRequest := &admin20231115002.CloudProviderContainer{
+		ProviderName:   admin20231115002.PtrString(provider),
+		AtlasCidrBlock: model.AtlasCidrBlock,
+	}
+
+	switch provider {
+	case constants.AZURE:
+		containerRequest.Region = model.Region
+	case constants.GCP:
+		containerRequest.Regions = model.Regions
+	default:
+		containerRequest.RegionName = model.RegionName
+	}
+	return containerRequest
+}
+
+func GetNetworkContainerModel(container *admin20231115002.CloudProviderContainer, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if container == nil {
+		return model
+	}
+
+	model.Id = container.Id
+	model.Provisioned = container.Provisioned
+	model.AtlasCidrBlock = container.AtlasCidrBlock
+	if container.ProviderName != nil {
+		model.CloudProvider = container.ProviderName
+	}
+
+	switch GetCloudProvider(model) {
+	case constants.AZURE:
+		model.Region = container.Region
+		model.AzureSubscriptionId = container.AzureSubscriptionId
+		model.VnetName = container.VnetName
+	case constants.GCP:
+		if len(container.Regions) > 0 {
+			model.Regions = container.Regions
+		}
+		model.GcpProjectId = container.GcpProjectId
+		model.NetworkName = container.NetworkName
+	default:
+		model.RegionName = container.RegionName
+		model.VpcId = container.VpcId
+	}
+	return model
+}
+
+// isContainerRegion reports whether the existing container serves the region requested for a new container.
+// A project only has one GCP container, which spans all the GCP regions.
+func isContainerRegion(container *admin20231115002.CloudProviderContainer, request *admin20231115002.CloudProviderContainer) bool {
+	switch request.GetProviderName() {
+	case constants.AZURE:
+		return container.GetRegion() == request.GetRegion()
+	case constants.GCP:
+		return true
+	default:
+		return container.GetRegionName() == request.GetRegionName()
+	}
+}
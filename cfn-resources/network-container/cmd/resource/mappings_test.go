@@ -0,0 +1,196 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/network-container/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+const containerID = "111111111111111111111111"
+
+func TestNewCloudProviderContainerReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin20231115002.CloudProviderContainer
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "AWS by default",
+			input: &resource.Model{
+				RegionName:     ptr.String("US_EAST_1"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+			},
+			expected: &admin20231115002.CloudProviderContainer{
+				ProviderName:   ptr.String(constants.AWS),
+				RegionName:     ptr.String("US_EAST_1"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+			},
+		},
+		{
+			name: "Azure",
+			input: &resource.Model{
+				CloudProvider:  ptr.String(constants.AZURE),
+				Region:         ptr.String("US_EAST_2"),
+				RegionName:     ptr.String("US_EAST_1"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+			},
+			expected: &admin20231115002.CloudProviderContainer{
+				ProviderName:   ptr.String(constants.AZURE),
+				Region:         ptr.String("US_EAST_2"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &resource.Model{
+				CloudProvider:  ptr.String(constants.GCP),
+				Regions:        []string{"US_EAST_4", "EUROPE_WEST_1"},
+				AtlasCidrBlock: ptr.String("10.8.0.0/18"),
+			},
+			expected: &admin20231115002.CloudProviderContainer{
+				ProviderName:   ptr.String(constants.GCP),
+				Regions:        []string{"US_EAST_4", "EUROPE_WEST_1"},
+				AtlasCidrBlock: ptr.String("10.8.0.0/18"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewCloudProviderContainerReq(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetNetworkContainerModel(t *testing.T) {
+	tests := []struct {
+		input    *admin20231115002.CloudProviderContainer
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "AWS",
+			input: &admin20231115002.CloudProviderContainer{
+				Id:             ptr.String(containerID),
+				ProviderName:   ptr.String(constants.AWS),
+				RegionName:     ptr.String("US_EAST_1"),
+				VpcId:          ptr.String("vpc-123"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+				Provisioned:    ptr.Bool(false),
+			},
+			expected: &resource.Model{
+				Id:             ptr.String(containerID),
+				CloudProvider:  ptr.String(constants.AWS),
+				RegionName:     ptr.String("US_EAST_1"),
+				VpcId:          ptr.String("vpc-123"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/21"),
+				Provisioned:    ptr.Bool(false),
+			},
+		},
+		{
+			name: "Azure",
+			input: &admin20231115002.CloudProviderContainer{
+				Id:                  ptr.String(containerID),
+				ProviderName:        ptr.String(constants.AZURE),
+				Region:              ptr.String("US_EAST_2"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				VnetName:            ptr.String("vnet"),
+				AtlasCidrBlock:      ptr.String("10.8.0.0/21"),
+			},
+			expected: &resource.Model{
+				Id:                  ptr.String(containerID),
+				CloudProvider:       ptr.String(constants.AZURE),
+				Region:              ptr.String("US_EAST_2"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				VnetName:            ptr.String("vnet"),
+				AtlasCidrBlock:      ptr.String("10.8.0.0/21"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &admin20231115002.CloudProviderContainer{
+				Id:             ptr.String(containerID),
+				ProviderName:   ptr.String(constants.GCP),
+				Regions:        []string{"US_EAST_4"},
+				GcpProjectId:   ptr.String("gcp-project"),
+				NetworkName:    ptr.String("network"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/18"),
+			},
+			expected: &resource.Model{
+				Id:             ptr.String(containerID),
+				CloudProvider:  ptr.String(constants.GCP),
+				Regions:        []string{"US_EAST_4"},
+				GcpProjectId:   ptr.String("gcp-project"),
+				NetworkName:    ptr.String("network"),
+				AtlasCidrBlock: ptr.String("10.8.0.0/18"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetNetworkContainerModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestValidateProviderFields(t *testing.T) {
+	tests := []struct {
+		input   *resource.Model
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "AWS without RegionName",
+			input:   &resource.Model{},
+			wantErr: true,
+		},
+		{
+			name:    "Azure without Region",
+			input:   &resource.Model{CloudProvider: ptr.String(constants.AZURE), RegionName: ptr.String("US_EAST_1")},
+			wantErr: true,
+		},
+		{
+			name:  "GCP without Regions",
+			input: &resource.Model{CloudProvider: ptr.String(constants.GCP)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.ValidateProviderFields(tt.input)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
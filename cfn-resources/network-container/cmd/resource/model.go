@@ -4,11 +4,18 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	ProjectId      *string `json:",omitempty"`
-	RegionName     *string `json:",omitempty"`
-	Provisioned    *bool   `json:",omitempty"`
-	VpcId          *string `json:",omitempty"`
-	AtlasCidrBlock *string `json:",omitempty"`
-	Id             *string `json:",omitempty"`
-	Profile        *string `json:",omitempty"`
+	ProjectId           *string  `json:",omitempty"`
+	CloudProvider       *string  `json:",omitempty"`
+	RegionName          *string  `json:",omitempty"`
+	Region              *string  `json:",omitempty"`
+	Regions             []string `json:",omitempty"`
+	Provisioned         *bool    `json:",omitempty"`
+	VpcId               *string  `json:",omitempty"`
+	AzureSubscriptionId *string  `json:",omitempty"`
+	VnetName            *string  `json:",omitempty"`
+	GcpProjectId        *string  `json:",omitempty"`
+	NetworkName         *string  `json:",omitempty"`
+	AtlasCidrBlock      *string  `json:",omitempty"`
+	Id                  *string  `json:",omitempty"`
+	Profile             *string  `json:",omitempty"`
 }
@@ -47,14 +47,9 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			response), nil
 	}
 
-	currentModel.RegionName = containerResponse.RegionName
-	currentModel.Provisioned = containerResponse.Provisioned
-	currentModel.VpcId = containerResponse.VpcId
-	currentModel.AtlasCidrBlock = containerResponse.AtlasCidrBlock
-
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Read Complete",
-		ResourceModel:   currentModel,
+		ResourceModel:   GetNetworkContainerModel(containerResponse, currentModel),
 	}, nil
 }
@@ -23,7 +23,6 @@ import (
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
-	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 var updateRequiredFields = []string{constants.ProjectID, constants.ID}
@@ -42,15 +41,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 	projectID := *currentModel.ProjectId
 	containerID := *currentModel.Id
-	containerRequest := &admin20231115002.CloudProviderContainer{}
-
-	CIDR := currentModel.AtlasCidrBlock
-	if CIDR != nil {
-		containerRequest.AtlasCidrBlock = CIDR
-	}
-
-	containerRequest.ProviderName = admin20231115002.PtrString(constants.AWS)
-	containerRequest.RegionName = currentModel.RegionName
+	containerRequest := NewCloudProviderContainerReq(currentModel)
 	containerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.UpdatePeeringContainer(context.Background(), projectID, containerID, containerRequest).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(fmt.Sprintf("Error getting resource : %s", err.Error()),
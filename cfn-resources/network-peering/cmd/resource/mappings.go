@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+// GetCloudProvider returns the CloudProvider of the model, AWS when it is not set.
+func GetCloudProvider(model *Model) string {
+	if util.IsStringPresent(model.CloudProvider) {
+		return *model.CloudProvider
+	}
+	return constants.AWS
+}
+
+func NewPeeringConnectionReq(model *Model) *admin20231115002.BaseNetworkPeeringConnectionSettings {
+	if model == nil {
+		return nil
+	}
+	provider := GetCloudProvider(model)
+	peerRequest := &admin20231115002.BaseNetworkPeeringConnectionSettings{
+		ContainerId:  util.SafeString(model.ContainerId),
+		ProviderName: admin20231115002.PtrString(provider),
+	}
+
+	switch provider {
+	case constants.AZURE:
+		peerRequest.AzureDirectoryId = model.AzureDirectoryId
+		peerRequest.AzureSubscriptionId = model.AzureSubscriptionId
+		peerRequest.ResourceGroupName = model.ResourceGroupName
+		peerRequest.VnetName = model.VnetName
+	case constants.GCP:
+		peerRequest.GcpProjectId = model.GcpProjectId
+		peerRequest.NetworkName = model.NetworkName
+	default:
+		peerRequest.AccepterRegionName = model.AccepterRegionName
+		peerRequest.AwsAccountId = model.AwsAccountId
+		peerRequest.RouteTableCidrBlock = model.RouteTableCIDRBlock
+		peerRequest.VpcId = model.VpcId
+	}
+	return peerRequest
+}
+
+func GetPeeringConnectionModel(peer *admin20231115002.BaseNetworkPeeringConnectionSettings, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if peer == nil {
+		return model
+	}
+
+	model.Id = peer.Id
+	if peer.ProviderName != nil {
+		model.CloudProvider = peer.ProviderName
+	}
+	status, errorState := GetPeeringStatus(peer)
+	model.StatusName = util.StringPtr(status)
+	model.ErrorStateName = util.StringPtr(errorState)
+
+	switch GetCloudProvider(model) {
+	case constants.AZURE:
+		model.AzureDirectoryId = peer.AzureDirectoryId
+		model.AzureSubscriptionId = peer.AzureSubscriptionId
+		model.ResourceGroupName = peer.ResourceGroupName
+		model.VnetName = peer.VnetName
+	case constants.GCP:
+		model.GcpProjectId = peer.GcpProjectId
+		model.NetworkName = peer.NetworkName
+	default:
+		if peer.AccepterRegionName != nil {
+			model.AccepterRegionName = peer.AccepterRegionName
+		}
+		model.AwsAccountId = peer.AwsAccountId
+		model.RouteTableCIDRBlock = peer.RouteTableCidrBlock
+		model.VpcId = peer.VpcId
+		model.ConnectionId = peer.ConnectionId
+	}
+	return model
+}
+
+// GetPeeringStatus returns the status and the error of the peering connection. AWS peering connections report them
+// in statusName and errorStateName, Azure peering connections in status and errorState and GCP peering connections
+// in status and errorMessage.
+func GetPeeringStatus(peer *admin20231115002.BaseNetworkPeeringConnectionSettings) (status, errorState string) {
+	switch peer.GetProviderName() {
+	case constants.AZURE:
+		return peer.GetStatus(), peer.GetErrorState()
+	case constants.GCP:
+		return peer.GetStatus(), peer.GetErrorMessage()
+	default:
+		return peer.GetStatusName(), peer.GetErrorStateName()
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/network-peering/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	containerID = "111111111111111111111111"
+	peerID      = "222222222222222222222222"
+)
+
+func TestNewPeeringConnectionReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin20231115002.BaseNetworkPeeringConnectionSettings
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "AWS by default",
+			input: &resource.Model{
+				ContainerId:         ptr.String(containerID),
+				AccepterRegionName:  ptr.String("us-east-1"),
+				AwsAccountId:        ptr.String("123456789012"),
+				RouteTableCIDRBlock: ptr.String("10.0.0.0/24"),
+				VpcId:               ptr.String("vpc-123"),
+				GcpProjectId:        ptr.String("gcp-project"),
+			},
+			expected: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				ContainerId:         containerID,
+				ProviderName:        ptr.String(constants.AWS),
+				AccepterRegionName:  ptr.String("us-east-1"),
+				AwsAccountId:        ptr.String("123456789012"),
+				RouteTableCidrBlock: ptr.String("10.0.0.0/24"),
+				VpcId:               ptr.String("vpc-123"),
+			},
+		},
+		{
+			name: "Azure",
+			input: &resource.Model{
+				ContainerId:         ptr.String(containerID),
+				CloudProvider:       ptr.String(constants.AZURE),
+				AzureDirectoryId:    ptr.String("directory"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				ResourceGroupName:   ptr.String("resource-group"),
+				VnetName:            ptr.String("vnet"),
+				VpcId:               ptr.String("vpc-123"),
+			},
+			expected: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				ContainerId:         containerID,
+				ProviderName:        ptr.String(constants.AZURE),
+				AzureDirectoryId:    ptr.String("directory"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				ResourceGroupName:   ptr.String("resource-group"),
+				VnetName:            ptr.String("vnet"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &resource.Model{
+				ContainerId:   ptr.String(containerID),
+				CloudProvider: ptr.String(constants.GCP),
+				GcpProjectId:  ptr.String("gcp-project"),
+				NetworkName:   ptr.String("network"),
+			},
+			expected: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				ContainerId:  containerID,
+				ProviderName: ptr.String(constants.GCP),
+				GcpProjectId: ptr.String("gcp-project"),
+				NetworkName:  ptr.String("network"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewPeeringConnectionReq(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetPeeringConnectionModel(t *testing.T) {
+	tests := []struct {
+		input    *admin20231115002.BaseNetworkPeeringConnectionSettings
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "AWS",
+			input: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				Id:                  ptr.String(peerID),
+				ContainerId:         containerID,
+				ProviderName:        ptr.String(constants.AWS),
+				AwsAccountId:        ptr.String("123456789012"),
+				RouteTableCidrBlock: ptr.String("10.0.0.0/24"),
+				VpcId:               ptr.String("vpc-123"),
+				ConnectionId:        ptr.String("pcx-123"),
+				StatusName:          ptr.String(resource.StatusPendingAcceptance),
+			},
+			expected: &resource.Model{
+				Id:                  ptr.String(peerID),
+				CloudProvider:       ptr.String(constants.AWS),
+				AwsAccountId:        ptr.String("123456789012"),
+				RouteTableCIDRBlock: ptr.String("10.0.0.0/24"),
+				VpcId:               ptr.String("vpc-123"),
+				ConnectionId:        ptr.String("pcx-123"),
+				StatusName:          ptr.String(resource.StatusPendingAcceptance),
+			},
+		},
+		{
+			name: "Azure",
+			input: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				Id:                  ptr.String(peerID),
+				ContainerId:         containerID,
+				ProviderName:        ptr.String(constants.AZURE),
+				AzureDirectoryId:    ptr.String("directory"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				ResourceGroupName:   ptr.String("resource-group"),
+				VnetName:            ptr.String("vnet"),
+				Status:              ptr.String(resource.StatusFailed),
+				ErrorState:          ptr.String("permissions missing"),
+			},
+			expected: &resource.Model{
+				Id:                  ptr.String(peerID),
+				CloudProvider:       ptr.String(constants.AZURE),
+				AzureDirectoryId:    ptr.String("directory"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				ResourceGroupName:   ptr.String("resource-group"),
+				VnetName:            ptr.String("vnet"),
+				StatusName:          ptr.String(resource.StatusFailed),
+				ErrorStateName:      ptr.String("permissions missing"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &admin20231115002.BaseNetworkPeeringConnectionSettings{
+				Id:           ptr.String(peerID),
+				ContainerId:  containerID,
+				ProviderName: ptr.String(constants.GCP),
+				GcpProjectId: ptr.String("gcp-project"),
+				NetworkName:  ptr.String("network"),
+				Status:       ptr.String(resource.StatusWaitingForUser),
+			},
+			expected: &resource.Model{
+				Id:            ptr.String(peerID),
+				CloudProvider: ptr.String(constants.GCP),
+				GcpProjectId:  ptr.String("gcp-project"),
+				NetworkName:   ptr.String("network"),
+				StatusName:    ptr.String(resource.StatusWaitingForUser),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetPeeringConnectionModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestValidateProviderFields(t *testing.T) {
+	tests := []struct {
+		input   *resource.Model
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "AWS missing VpcId",
+			input: &resource.Model{
+				AccepterRegionName:  ptr.String("us-east-1"),
+				AwsAccountId:        ptr.String("123456789012"),
+				RouteTableCIDRBlock: ptr.String("10.0.0.0/24"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Azure",
+			input: &resource.Model{
+				CloudProvider:       ptr.String(constants.AZURE),
+				AzureDirectoryId:    ptr.String("directory"),
+				AzureSubscriptionId: ptr.String("subscription"),
+				ResourceGroupName:   ptr.String("resource-group"),
+				VnetName:            ptr.String("vnet"),
+			},
+		},
+		{
+			name: "GCP missing NetworkName",
+			input: &resource.Model{
+				CloudProvider: ptr.String(constants.GCP),
+				GcpProjectId:  ptr.String("gcp-project"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported provider",
+			input: &resource.Model{
+				CloudProvider: ptr.String("OCI"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errEvent := resource.ValidateProviderFields(tt.input)
+			assert.Equal(t, tt.wantErr, errEvent != nil)
+		})
+	}
+}
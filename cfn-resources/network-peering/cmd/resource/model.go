@@ -6,10 +6,17 @@ package resource
 type Model struct {
 	ProjectId           *string `json:",omitempty"`
 	ContainerId         *string `json:",omitempty"`
+	CloudProvider       *string `json:",omitempty"`
 	AccepterRegionName  *string `json:",omitempty"`
 	AwsAccountId        *string `json:",omitempty"`
 	RouteTableCIDRBlock *string `json:",omitempty"`
 	VpcId               *string `json:",omitempty"`
+	AzureDirectoryId    *string `json:",omitempty"`
+	AzureSubscriptionId *string `json:",omitempty"`
+	ResourceGroupName   *string `json:",omitempty"`
+	VnetName            *string `json:",omitempty"`
+	GcpProjectId        *string `json:",omitempty"`
+	NetworkName         *string `json:",omitempty"`
 	ConnectionId        *string `json:",omitempty"`
 	ErrorStateName      *string `json:",omitempty"`
 	StatusName          *string `json:",omitempty"`
@@ -42,6 +42,7 @@ const (
 	StatusAvailable         string = "AVAILABLE"
 	StatusDeleted           string = "DELETED"
 	StatusInitiating        string = "INITIATING"
+	StatusWaitingForUser    string = "WAITING_FOR_USER"
 )
 
 // Helper to check container id or create one for the AWS region for
@@ -59,21 +60,35 @@ var (
 	DefaultRouteTableCIDRBlock = "10.0.0.0/24"
 )
 
-var CreateRequiredFields = []string{constants.ProjectID, constants.ContainerID,
-	constants.AccepterRegionName, constants.AwsAccountID, constants.RouteTableCIDRBlock, constants.VPCID}
-
+var CreateRequiredFields = []string{constants.ProjectID, constants.ContainerID}
 var ReadRequiredFields = []string{constants.ProjectID, constants.ID}
-var UpdateRequiredFields = []string{constants.ProjectID, constants.AccepterRegionName,
-	constants.AwsAccountID, constants.RouteTableCIDRBlock}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.ContainerID}
 
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID}
 var ListRequiredFields = []string{constants.ProjectID}
 
+var requiredPerProvider = map[string][]string{
+	constants.AWS:   {constants.AccepterRegionName, constants.AwsAccountID, constants.RouteTableCIDRBlock, constants.VPCID},
+	constants.AZURE: {"AzureDirectoryId", "AzureSubscriptionId", "ResourceGroupName", "VnetName"},
+	constants.GCP:   {"GcpProjectId", "NetworkName"},
+}
+
 // validateModel to validate inputs to all actions
 func validateModel(fields []string, model *Model) *handler.ProgressEvent {
 	return validator.ValidateModel(fields, model)
 }
 
+// ValidateProviderFields checks that the properties required by the CloudProvider of the peering connection are set.
+func ValidateProviderFields(model *Model) *handler.ProgressEvent {
+	provider := GetCloudProvider(model)
+	requiredFields, ok := requiredPerProvider[provider]
+	if !ok {
+		return util.Pointer(progressevent.GetFailedEventByCode(fmt.Sprintf("unsupported CloudProvider %s", provider),
+			string(types.HandlerErrorCodeInvalidRequest)))
+	}
+	return validateModel(requiredFields, model)
+}
+
 // Create handles the Create event from the Cloudformation service.
 func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	setup()
@@ -82,6 +97,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *errEvent, nil
 	}
 
+	if errEvent := ValidateProviderFields(currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
 	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
 	if peErr != nil {
@@ -94,21 +113,12 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 
 	projectID := *currentModel.ProjectId
-	awsAccountID := currentModel.AwsAccountId
-	if awsAccountID == nil || *awsAccountID == "" {
-		awsAccountID = &req.RequestContext.AccountID
+	peerRequest := NewPeeringConnectionReq(currentModel)
+	if GetCloudProvider(currentModel) == constants.AWS && !util.IsStringPresent(peerRequest.AwsAccountId) {
+		peerRequest.AwsAccountId = &req.RequestContext.AccountID
 	}
 
-	peerRequest := admin20231115002.BaseNetworkPeeringConnectionSettings{
-		ContainerId:         *currentModel.ContainerId,
-		VpcId:               currentModel.VpcId,
-		AccepterRegionName:  currentModel.AccepterRegionName,
-		AwsAccountId:        awsAccountID,
-		RouteTableCidrBlock: currentModel.RouteTableCIDRBlock,
-		ProviderName:        admin20231115002.PtrString(constants.AWS),
-	}
-
-	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.CreatePeeringConnection(context.Background(), projectID, &peerRequest).Execute()
+	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.CreatePeeringConnection(context.Background(), projectID, peerRequest).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(),
 			resp), nil
@@ -147,29 +157,24 @@ func Read(req handler.Request, prevModel, currentModel *Model) (handler.Progress
 			resp), nil
 	}
 
-	currentModel.AwsAccountId = peerResponse.AwsAccountId
-	currentModel.RouteTableCIDRBlock = peerResponse.RouteTableCidrBlock
-	currentModel.VpcId = peerResponse.VpcId
-	currentModel.Id = peerResponse.Id
-	currentModel.ConnectionId = peerResponse.ConnectionId
-	currentModel.ErrorStateName = peerResponse.ErrorStateName
-	if currentModel.ErrorStateName != nil {
-		currentModel.ErrorStateName = peerResponse.ErrorStateName
-	}
-	if currentModel.StatusName != nil {
-		currentModel.StatusName = peerResponse.StatusName
-	}
-
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
 		Message:         "Read Complete",
-		ResourceModel:   currentModel,
+		ResourceModel:   GetPeeringConnectionModel(peerResponse, currentModel),
 	}, nil
 }
 
 // Update handles the Update event from the Cloudformation service.
 func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	setup()
+	if errEvent := validateModel(UpdateRequiredFields, currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
+	if errEvent := ValidateProviderFields(currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
 	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
 	if peErr != nil {
@@ -185,27 +190,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 
 	peerID := *currentModel.Id
-	peerRequest := admin20231115002.BaseNetworkPeeringConnectionSettings{}
-
-	if region := currentModel.AccepterRegionName; region != nil {
-		peerRequest.AccepterRegionName = region
-	}
-
-	if accountID := currentModel.AwsAccountId; accountID != nil {
-		peerRequest.AwsAccountId = accountID
-	}
-
-	peerRequest.ProviderName = admin20231115002.PtrString(constants.AWS)
-	if rtTableBlock := currentModel.RouteTableCIDRBlock; rtTableBlock != nil {
-		peerRequest.RouteTableCidrBlock = rtTableBlock
-	}
-
-	if vpcID := currentModel.VpcId; vpcID != nil {
-		peerRequest.VpcId = vpcID
-	}
-
-	peerRequest.ContainerId = *currentModel.ContainerId
-	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.UpdatePeeringConnection(context.Background(), projectID, peerID, &peerRequest).Execute()
+	peerRequest := NewPeeringConnectionReq(currentModel)
+	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.UpdatePeeringConnection(context.Background(), projectID, peerID, peerRequest).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -265,8 +251,11 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return *peErr, nil
 	}
 
-	projectID := *currentModel.ProjectId
-	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringConnections(context.Background(), projectID).Execute()
+	peerRequest := &admin20231115002.ListPeeringConnectionsApiParams{
+		ProviderName: admin20231115002.PtrString(GetCloudProvider(currentModel)),
+		GroupId:      *currentModel.ProjectId,
+	}
+	peerResponse, resp, err := client.Atlas20231115002.NetworkPeeringApi.ListPeeringConnectionsWithParams(context.Background(), peerRequest).Execute()
 	if err != nil {
 		return progressevent.GetFailedEventByResponse(err.Error(), resp), nil
 	}
@@ -274,17 +263,10 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	models := make([]interface{}, 0)
 	networkPeeringConnections := peerResponse.Results
 	for i := range networkPeeringConnections {
-		var model Model
-		model.AccepterRegionName = networkPeeringConnections[i].AccepterRegionName
-		model.AwsAccountId = networkPeeringConnections[i].AwsAccountId
-		model.RouteTableCIDRBlock = networkPeeringConnections[i].RouteTableCidrBlock
-		model.VpcId = networkPeeringConnections[i].VpcId
-		model.Id = networkPeeringConnections[i].Id
-		model.ConnectionId = networkPeeringConnections[i].ConnectionId
-		model.ErrorStateName = networkPeeringConnections[i].ErrorStateName
-		model.StatusName = networkPeeringConnections[i].StatusName
-
-		models = append(models, model)
+		model := GetPeeringConnectionModel(&networkPeeringConnections[i], nil)
+		model.ContainerId = util.Pointer(networkPeeringConnections[i].ContainerId)
+
+		models = append(models, *model)
 	}
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -324,7 +306,8 @@ func validateCreationProcess(client *util.MongoDBClient, currentModel *Model) ha
 		return progressevent.GetFailedEventByCode("Creation failed", string(types.HandlerErrorCodeInternalFailure))
 	}
 
-	if state == StatusPendingAcceptance || state == StatusAvailable {
+	// AWS and GCP peering connections wait for the peer to accept them, Azure ones become available directly
+	if state == StatusPendingAcceptance || state == StatusWaitingForUser || state == StatusAvailable {
 		return handler.ProgressEvent{
 			OperationStatus: handler.Success,
 			Message:         "Complete",
@@ -352,12 +335,9 @@ func getStatus(client *util.MongoDBClient, projectID, peerID string) (statusName
 		return "", err
 	}
 
-	if util.IsStringPresent(peerResponse.ErrorStateName) {
-		err = errors.New(*peerResponse.ErrorStateName)
-	}
-
-	if util.IsStringPresent(peerResponse.StatusName) {
-		statusName = *peerResponse.StatusName
+	statusName, errorState := GetPeeringStatus(peerResponse)
+	if errorState != "" {
+		err = errors.New(errorState)
 	}
 
 	return
@@ -0,0 +1,50 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+)
+
+// NewOrgSettingsReq only sets the settings declared in the model, so the others are left unchanged.
+func NewOrgSettingsReq(model *Model) *admin.OrganizationSettings {
+	return &admin.OrganizationSettings{
+		ApiAccessListRequired:                  model.ApiAccessListRequired,
+		MultiFactorAuthRequired:                model.MultiFactorAuthRequired,
+		RestrictEmployeeAccess:                 model.RestrictEmployeeAccess,
+		GenAIFeaturesEnabled:                   model.GenAIFeaturesEnabled,
+		SecurityContact:                        model.SecurityContact,
+		MaxServiceAccountSecretValidityInHours: model.MaxServiceAccountSecretValidityInHours,
+		StreamsCrossGroupEnabled:               model.StreamsCrossGroupEnabled,
+	}
+}
+
+func GetOrgSettingsModel(settings *admin.OrganizationSettings, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.OrgId = currentModel.OrgId
+	}
+	if settings != nil {
+		model.ApiAccessListRequired = settings.ApiAccessListRequired
+		model.MultiFactorAuthRequired = settings.MultiFactorAuthRequired
+		model.RestrictEmployeeAccess = settings.RestrictEmployeeAccess
+		model.GenAIFeaturesEnabled = settings.GenAIFeaturesEnabled
+		model.SecurityContact = settings.SecurityContact
+		model.MaxServiceAccountSecretValidityInHours = settings.MaxServiceAccountSecretValidityInHours
+		model.StreamsCrossGroupEnabled = settings.StreamsCrossGroupEnabled
+	}
+	return model
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/org-settings/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrgSettingsReq(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		expected *admin.OrganizationSettings
+		name     string
+	}{
+		{
+			name:     "No Settings",
+			model:    &resource.Model{OrgId: ptr.String("111111111111111111111111")},
+			expected: &admin.OrganizationSettings{},
+		},
+		{
+			name: "All Settings",
+			model: &resource.Model{
+				OrgId:                                  ptr.String("111111111111111111111111"),
+				ApiAccessListRequired:                  ptr.Bool(true),
+				MultiFactorAuthRequired:                ptr.Bool(true),
+				RestrictEmployeeAccess:                 ptr.Bool(false),
+				GenAIFeaturesEnabled:                   ptr.Bool(false),
+				SecurityContact:                        ptr.String("security@example.com"),
+				MaxServiceAccountSecretValidityInHours: ptr.Int(24),
+				StreamsCrossGroupEnabled:               ptr.Bool(true),
+			},
+			expected: &admin.OrganizationSettings{
+				ApiAccessListRequired:                  ptr.Bool(true),
+				MultiFactorAuthRequired:                ptr.Bool(true),
+				RestrictEmployeeAccess:                 ptr.Bool(false),
+				GenAIFeaturesEnabled:                   ptr.Bool(false),
+				SecurityContact:                        ptr.String("security@example.com"),
+				MaxServiceAccountSecretValidityInHours: ptr.Int(24),
+				StreamsCrossGroupEnabled:               ptr.Bool(true),
+			},
+		},
+		{
+			name: "Clear Security Contact",
+			model: &resource.Model{
+				OrgId:           ptr.String("111111111111111111111111"),
+				SecurityContact: ptr.String(""),
+			},
+			expected: &admin.OrganizationSettings{
+				SecurityContact: ptr.String(""),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.NewOrgSettingsReq(tc.model))
+		})
+	}
+}
+
+func TestGetOrgSettingsModel(t *testing.T) {
+	tests := []struct {
+		settings     *admin.OrganizationSettings
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Settings",
+			settings: &admin.OrganizationSettings{
+				ApiAccessListRequired:                  ptr.Bool(false),
+				MultiFactorAuthRequired:                ptr.Bool(true),
+				RestrictEmployeeAccess:                 ptr.Bool(true),
+				GenAIFeaturesEnabled:                   ptr.Bool(true),
+				SecurityContact:                        ptr.String("security@example.com"),
+				MaxServiceAccountSecretValidityInHours: ptr.Int(48),
+				StreamsCrossGroupEnabled:               ptr.Bool(false),
+			},
+			currentModel: &resource.Model{
+				Profile:         ptr.String("default"),
+				OrgId:           ptr.String("111111111111111111111111"),
+				SecurityContact: ptr.String("old@example.com"),
+			},
+			expected: &resource.Model{
+				Profile:                                ptr.String("default"),
+				OrgId:                                  ptr.String("111111111111111111111111"),
+				ApiAccessListRequired:                  ptr.Bool(false),
+				MultiFactorAuthRequired:                ptr.Bool(true),
+				RestrictEmployeeAccess:                 ptr.Bool(true),
+				GenAIFeaturesEnabled:                   ptr.Bool(true),
+				SecurityContact:                        ptr.String("security@example.com"),
+				MaxServiceAccountSecretValidityInHours: ptr.Int(48),
+				StreamsCrossGroupEnabled:               ptr.Bool(false),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetOrgSettingsModel(tc.settings, tc.currentModel))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var RequiredFields = []string{constants.OrgID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-org-settings")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create applies the declared settings to an existing organization. There is nothing to create in Atlas.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	settings, apiResp, err := conn.OrganizationsApi.UpdateOrgSettings(context.Background(), *currentModel.OrgId, NewOrgSettingsReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   GetOrgSettingsModel(settings, currentModel),
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	settings, apiResp, err := conn.OrganizationsApi.GetOrgSettings(context.Background(), *currentModel.OrgId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetOrgSettingsModel(settings, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	settings, apiResp, err := conn.OrganizationsApi.UpdateOrgSettings(context.Background(), *currentModel.OrgId, NewOrgSettingsReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetOrgSettingsModel(settings, currentModel),
+	}, nil
+}
+
+// Delete stops managing the settings and leaves them as they are, since organization settings can't be removed.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if _, apiResp, err := conn.OrganizationsApi.GetOrgSettings(context.Background(), *currentModel.OrgId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+// List returns the settings of the organization, which are the only ones that can exist for it.
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	settings, apiResp, err := conn.OrganizationsApi.GetOrgSettings(context.Background(), *currentModel.OrgId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  []any{GetOrgSettingsModel(settings, currentModel)},
+	}, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// NewOrgUserUpdateReq only sets the organization roles, so the project roles and teams of the user are left unchanged.
+func NewOrgUserUpdateReq(model *Model) *admin.OrgUserUpdateRequest {
+	return &admin.OrgUserUpdateRequest{
+		Roles: admin.NewOrgUserRolesRequest(model.OrgRoles),
+	}
+}
+
+func GetOrgUserModel(user *admin.OrgUserResponse, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.OrgId = currentModel.OrgId
+	}
+	if user != nil {
+		model.UserId = util.StringPtr(user.Id)
+		model.Username = util.StringPtr(user.Username)
+		model.OrgRoles = user.Roles.GetOrgRoles()
+		model.OrgMembershipStatus = util.StringPtr(user.OrgMembershipStatus)
+	}
+	return model
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/org-user/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrgUserUpdateReq(t *testing.T) {
+	model := &resource.Model{
+		OrgId:    ptr.String("111111111111111111111111"),
+		UserId:   ptr.String("222222222222222222222222"),
+		OrgRoles: []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"},
+	}
+	expected := &admin.OrgUserUpdateRequest{
+		Roles: &admin.OrgUserRolesRequest{OrgRoles: []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"}},
+	}
+	assert.Equal(t, expected, resource.NewOrgUserUpdateReq(model))
+}
+
+func TestGetOrgUserModel(t *testing.T) {
+	tests := []struct {
+		user         *admin.OrgUserResponse
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Active User",
+			user: &admin.OrgUserResponse{
+				Id:                  "222222222222222222222222",
+				Username:            "user@example.com",
+				OrgMembershipStatus: "ACTIVE",
+				Roles: admin.OrgUserRolesResponse{
+					OrgRoles: &[]string{"ORG_MEMBER"},
+					GroupRoleAssignments: &[]admin.GroupRoleAssignment{
+						{GroupId: ptr.String("333333333333333333333333"), GroupRoles: &[]string{"GROUP_READ_ONLY"}},
+					},
+				},
+			},
+			currentModel: &resource.Model{
+				Profile: ptr.String("default"),
+				OrgId:   ptr.String("111111111111111111111111"),
+			},
+			expected: &resource.Model{
+				Profile:             ptr.String("default"),
+				OrgId:               ptr.String("111111111111111111111111"),
+				UserId:              ptr.String("222222222222222222222222"),
+				Username:            ptr.String("user@example.com"),
+				OrgRoles:            []string{"ORG_MEMBER"},
+				OrgMembershipStatus: ptr.String("ACTIVE"),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetOrgUserModel(tc.user, tc.currentModel))
+		})
+	}
+}
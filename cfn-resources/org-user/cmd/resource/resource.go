@@ -0,0 +1,186 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const defaultItemsPerPage = 100
+
+var CreateRequiredFields = []string{constants.OrgID, constants.Username, constants.OrgRoles}
+var ReadRequiredFields = []string{constants.OrgID, constants.CloudUserID}
+var UpdateRequiredFields = []string{constants.OrgID, constants.CloudUserID, constants.OrgRoles}
+var DeleteRequiredFields = []string{constants.OrgID, constants.CloudUserID}
+var ListRequiredFields = []string{constants.OrgID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-org-user")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create takes over the organization roles of a user who is already a member of the organization.
+// Users who aren't members must be invited with MongoDB::Atlas::OrgInvitation instead.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	orgID := *currentModel.OrgId
+	username := *currentModel.Username
+	users, apiResp, err := conn.MongoDBCloudUsersApi.ListOrgUsers(ctx, orgID).Username(username).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	if len(users.GetResults()) == 0 {
+		return progress_events.GetFailedEventByCode(
+			fmt.Sprintf("user %s is not a member of the organization, use MongoDB::Atlas::OrgInvitation to invite them", username),
+			string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	userID := users.GetResults()[0].Id
+	user, apiResp, err := conn.MongoDBCloudUsersApi.UpdateOrgUser(ctx, orgID, userID, NewOrgUserUpdateReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   GetOrgUserModel(user, currentModel),
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	user, apiResp, err := conn.MongoDBCloudUsersApi.GetOrgUser(context.Background(), *currentModel.OrgId, *currentModel.UserId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetOrgUserModel(user, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	user, apiResp, err := conn.MongoDBCloudUsersApi.UpdateOrgUser(context.Background(), *currentModel.OrgId, *currentModel.UserId, NewOrgUserUpdateReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetOrgUserModel(user, currentModel),
+	}, nil
+}
+
+// Delete removes the user from the organization and all of its projects.
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if apiResp, err := conn.MongoDBCloudUsersApi.RemoveOrgUser(context.Background(), *currentModel.OrgId, *currentModel.UserId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	users, apiResp, err := getAllOrgUsers(context.Background(), conn, *currentModel.OrgId)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(users))
+	for i := range users {
+		models = append(models, GetOrgUserModel(&users[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func getAllOrgUsers(ctx context.Context, conn *admin.APIClient, orgID string) ([]admin.OrgUserResponse, *http.Response, error) {
+	users := make([]admin.OrgUserResponse, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, apiResp, err := conn.MongoDBCloudUsersApi.ListOrgUsers(ctx, orgID).
+			PageNum(pageNum).ItemsPerPage(defaultItemsPerPage).Execute()
+		if err != nil {
+			return nil, apiResp, err
+		}
+		results := page.GetResults()
+		users = append(users, results...)
+		if len(results) == 0 || page.GetTotalCount() <= len(users) {
+			return users, nil, nil
+		}
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
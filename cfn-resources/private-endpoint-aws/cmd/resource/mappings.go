@@ -0,0 +1,151 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var requiredPerProvider = map[string][]string{
+	constants.AWS:   {},
+	constants.AZURE: {"PrivateEndpointIPAddress"},
+	constants.GCP:   {"GcpProjectId", "Endpoints"},
+}
+
+// GetCloudProvider returns the CloudProvider of the model, AWS when it is not set.
+func GetCloudProvider(model *Model) string {
+	if util.IsStringPresent(model.CloudProvider) {
+		return *model.CloudProvider
+	}
+	return constants.AWS
+}
+
+// ValidateProviderFields checks that the properties required by the CloudProvider of the private endpoint are set.
+func ValidateProviderFields(model *Model) *handler.ProgressEvent {
+	provider := GetCloudProvider(model)
+	requiredFields, ok := requiredPerProvider[provider]
+	if !ok {
+		return util.Pointer(progress_events.GetFailedEventByCode(fmt.Sprintf("unsupported CloudProvider %s", provider),
+			string(types.HandlerErrorCodeInvalidRequest)))
+	}
+	return validator.ValidateModel(requiredFields, model)
+}
+
+func NewCreateEndpointReq(model *Model) *admin20231115014.CreateEndpointRequest {
+	if model == nil {
+		return nil
+	}
+
+	switch GetCloudProvider(model) {
+	case constants.AZURE:
+		return &admin20231115014.CreateEndpointRequest{
+			Id:                       model.Id,
+			PrivateEndpointIPAddress: model.PrivateEndpointIPAddress,
+		}
+	case constants.GCP:
+		endpoints := make([]admin20231115014.CreateGCPForwardingRuleRequest, 0, len(model.Endpoints))
+		for i := range model.Endpoints {
+			endpoints = append(endpoints, admin20231115014.CreateGCPForwardingRuleRequest{
+				EndpointName: model.Endpoints[i].EndpointName,
+				IpAddress:    model.Endpoints[i].IpAddress,
+			})
+		}
+		return &admin20231115014.CreateEndpointRequest{
+			EndpointGroupName: model.Id,
+			GcpProjectId:      model.GcpProjectId,
+			Endpoints:         &endpoints,
+		}
+	default:
+		return &admin20231115014.CreateEndpointRequest{
+			Id: model.Id,
+		}
+	}
+}
+
+// GetEndpointStatus returns the status of the private endpoint. AWS reports it in connectionStatus, Azure and GCP in status.
+func GetEndpointStatus(endpoint *admin20231115014.PrivateLinkEndpoint) string {
+	if endpoint == nil {
+		return ""
+	}
+	if endpoint.CloudProvider == constants.AZURE || endpoint.CloudProvider == constants.GCP {
+		return endpoint.GetStatus()
+	}
+	return endpoint.GetConnectionStatus()
+}
+
+// GetEndpointIDs returns the IDs of the private endpoints of the endpoint service, which depend on its cloud provider.
+func GetEndpointIDs(service *admin20231115014.EndpointService, provider string) []string {
+	if service == nil {
+		return nil
+	}
+	switch provider {
+	case constants.AZURE:
+		return service.GetPrivateEndpoints()
+	case constants.GCP:
+		return service.GetEndpointGroupNames()
+	default:
+		return service.GetInterfaceEndpoints()
+	}
+}
+
+func GetPrivateEndpointModel(endpoint *admin20231115014.PrivateLinkEndpoint, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if endpoint == nil {
+		return model
+	}
+
+	if endpoint.CloudProvider != "" {
+		model.CloudProvider = util.Pointer(endpoint.CloudProvider)
+	}
+	model.ErrorMessage = endpoint.ErrorMessage
+	model.ConnectionStatus = util.StringPtr(GetEndpointStatus(endpoint))
+
+	id := endpoint.InterfaceEndpointId
+	switch GetCloudProvider(model) {
+	case constants.AZURE:
+		id = endpoint.PrivateEndpointResourceId
+		model.PrivateEndpointIPAddress = endpoint.PrivateEndpointIPAddress
+		model.PrivateEndpointConnectionName = endpoint.PrivateEndpointConnectionName
+	case constants.GCP:
+		id = endpoint.EndpointGroupName
+		endpoints := make([]GcpEndpoint, 0, len(endpoint.GetEndpoints()))
+		for _, rule := range endpoint.GetEndpoints() {
+			endpoints = append(endpoints, GcpEndpoint{
+				EndpointName: rule.EndpointName,
+				IpAddress:    rule.IpAddress,
+				Status:       rule.Status,
+			})
+		}
+		model.Endpoints = endpoints
+	}
+	if id != nil {
+		model.Id = id
+	}
+	return model
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-aws/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+const azureEndpointID = "/subscriptions/1/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"
+
+func TestNewCreateEndpointReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin20231115014.CreateEndpointRequest
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "AWS by default",
+			input:    &resource.Model{Id: ptr.String("vpce-123"), PrivateEndpointIPAddress: ptr.String("10.0.0.4")},
+			expected: &admin20231115014.CreateEndpointRequest{Id: ptr.String("vpce-123")},
+		},
+		{
+			name: "Azure",
+			input: &resource.Model{
+				CloudProvider:            ptr.String(constants.AZURE),
+				Id:                       ptr.String(azureEndpointID),
+				PrivateEndpointIPAddress: ptr.String("10.0.0.4"),
+			},
+			expected: &admin20231115014.CreateEndpointRequest{
+				Id:                       ptr.String(azureEndpointID),
+				PrivateEndpointIPAddress: ptr.String("10.0.0.4"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &resource.Model{
+				CloudProvider: ptr.String(constants.GCP),
+				Id:            ptr.String("group"),
+				GcpProjectId:  ptr.String("gcp-project"),
+				Endpoints: []resource.GcpEndpoint{
+					{EndpointName: ptr.String("group-0"), IpAddress: ptr.String("10.0.0.10")},
+				},
+			},
+			expected: &admin20231115014.CreateEndpointRequest{
+				EndpointGroupName: ptr.String("group"),
+				GcpProjectId:      ptr.String("gcp-project"),
+				Endpoints: &[]admin20231115014.CreateGCPForwardingRuleRequest{
+					{EndpointName: ptr.String("group-0"), IpAddress: ptr.String("10.0.0.10")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.NewCreateEndpointReq(tt.input))
+		})
+	}
+}
+
+func TestValidateProviderFields(t *testing.T) {
+	tests := []struct {
+		input         *resource.Model
+		name          string
+		expectedError bool
+	}{
+		{
+			name:          "AWS",
+			input:         &resource.Model{Id: ptr.String("vpce-123")},
+			expectedError: false,
+		},
+		{
+			name:          "Azure without IP address",
+			input:         &resource.Model{CloudProvider: ptr.String(constants.AZURE), Id: ptr.String(azureEndpointID)},
+			expectedError: true,
+		},
+		{
+			name:          "GCP without endpoints",
+			input:         &resource.Model{CloudProvider: ptr.String(constants.GCP), Id: ptr.String("group"), GcpProjectId: ptr.String("gcp-project")},
+			expectedError: true,
+		},
+		{
+			name:          "Unsupported provider",
+			input:         &resource.Model{CloudProvider: ptr.String("OTHER")},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, resource.ValidateProviderFields(tt.input) != nil)
+		})
+	}
+}
+
+func TestGetPrivateEndpointModel(t *testing.T) {
+	tests := []struct {
+		input    *admin20231115014.PrivateLinkEndpoint
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name: "AWS",
+			input: &admin20231115014.PrivateLinkEndpoint{
+				CloudProvider:       constants.AWS,
+				InterfaceEndpointId: ptr.String("vpce-123"),
+				ConnectionStatus:    ptr.String(resource.Available),
+			},
+			expected: &resource.Model{
+				CloudProvider:    ptr.String(constants.AWS),
+				Id:               ptr.String("vpce-123"),
+				ConnectionStatus: ptr.String(resource.Available),
+			},
+		},
+		{
+			name: "Azure",
+			input: &admin20231115014.PrivateLinkEndpoint{
+				CloudProvider:                 constants.AZURE,
+				PrivateEndpointResourceId:     ptr.String(azureEndpointID),
+				PrivateEndpointIPAddress:      ptr.String("10.0.0.4"),
+				PrivateEndpointConnectionName: ptr.String("pe-connection"),
+				Status:                        ptr.String("INITIATING"),
+			},
+			expected: &resource.Model{
+				CloudProvider:                 ptr.String(constants.AZURE),
+				Id:                            ptr.String(azureEndpointID),
+				PrivateEndpointIPAddress:      ptr.String("10.0.0.4"),
+				PrivateEndpointConnectionName: ptr.String("pe-connection"),
+				ConnectionStatus:              ptr.String("INITIATING"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &admin20231115014.PrivateLinkEndpoint{
+				CloudProvider:     constants.GCP,
+				EndpointGroupName: ptr.String("group"),
+				Status:            ptr.String(resource.Failed),
+				ErrorMessage:      ptr.String("forwarding rule not found"),
+				Endpoints: &[]admin20231115014.GCPConsumerForwardingRule{
+					{EndpointName: ptr.String("group-0"), IpAddress: ptr.String("10.0.0.10"), Status: ptr.String(resource.Failed)},
+				},
+			},
+			expected: &resource.Model{
+				CloudProvider:    ptr.String(constants.GCP),
+				Id:               ptr.String("group"),
+				ConnectionStatus: ptr.String(resource.Failed),
+				ErrorMessage:     ptr.String("forwarding rule not found"),
+				Endpoints: []resource.GcpEndpoint{
+					{EndpointName: ptr.String("group-0"), IpAddress: ptr.String("10.0.0.10"), Status: ptr.String(resource.Failed)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.GetPrivateEndpointModel(tt.input, nil))
+		})
+	}
+}
+
+func TestGetEndpointIDs(t *testing.T) {
+	service := &admin20231115014.EndpointService{
+		InterfaceEndpoints: &[]string{"vpce-123"},
+		PrivateEndpoints:   &[]string{azureEndpointID},
+		EndpointGroupNames: &[]string{"group"},
+	}
+	assert.Equal(t, []string{"vpce-123"}, resource.GetEndpointIDs(service, constants.AWS))
+	assert.Equal(t, []string{azureEndpointID}, resource.GetEndpointIDs(service, constants.AZURE))
+	assert.Equal(t, []string{"group"}, resource.GetEndpointIDs(service, constants.GCP))
+}
+
+func TestIsTerminalStatus(t *testing.T) {
+	assert.True(t, resource.IsTerminalStatus("available"))
+	assert.True(t, resource.IsTerminalStatus(resource.Rejected))
+	assert.True(t, resource.IsTerminalStatus(resource.Failed))
+	assert.False(t, resource.IsTerminalStatus("PENDING_ACCEPTANCE"))
+	assert.False(t, resource.IsTerminalStatus("INITIATING"))
+}
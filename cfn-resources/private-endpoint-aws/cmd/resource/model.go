@@ -4,11 +4,23 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	Profile                  *string `json:",omitempty"`
-	ProjectId                *string `json:",omitempty"`
-	EndpointServiceId        *string `json:",omitempty"`
-	Id                       *string `json:",omitempty"`
-	EnforceConnectionSuccess *bool   `json:",omitempty"`
-	ConnectionStatus         *string `json:",omitempty"`
-	ErrorMessage             *string `json:",omitempty"`
+	Profile                       *string       `json:",omitempty"`
+	ProjectId                     *string       `json:",omitempty"`
+	EndpointServiceId             *string       `json:",omitempty"`
+	CloudProvider                 *string       `json:",omitempty"`
+	Id                            *string       `json:",omitempty"`
+	PrivateEndpointIPAddress      *string       `json:",omitempty"`
+	PrivateEndpointConnectionName *string       `json:",omitempty"`
+	GcpProjectId                  *string       `json:",omitempty"`
+	Endpoints                     []GcpEndpoint `json:",omitempty"`
+	EnforceConnectionSuccess      *bool         `json:",omitempty"`
+	ConnectionStatus              *string       `json:",omitempty"`
+	ErrorMessage                  *string       `json:",omitempty"`
+}
+
+// GcpEndpoint is autogenerated from the json schema
+type GcpEndpoint struct {
+	EndpointName *string `json:",omitempty"`
+	IpAddress    *string `json:",omitempty"`
+	Status       *string `json:",omitempty"`
 }
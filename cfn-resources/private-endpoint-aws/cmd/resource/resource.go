@@ -41,23 +41,28 @@ func setup() {
 const (
 	Available         = "AVAILABLE"
 	Rejected          = "REJECTED"
+	Failed            = "FAILED"
 	EndpointServiceID = "EndpointServiceId"
-	CloudProvider     = "AWS"
 )
 
 func IsTerminalStatus(status string) bool {
 	// Convert the status to uppercase to handle case-insensitivity
 	status = strings.ToUpper(status)
 
-	// Check if the status is "AVAILABLE" or "REJECTED"
-	return status == Available || status == Rejected
+	// Check if the status is "AVAILABLE", "REJECTED" (AWS) or "FAILED" (Azure and GCP)
+	return status == Available || IsFailedStatus(status)
+}
+
+func IsFailedStatus(status string) bool {
+	status = strings.ToUpper(status)
+	return status == Rejected || status == Failed
 }
 
 var CreateRequiredFields = []string{constants.ProjectID, EndpointServiceID, constants.ID}
 var ReadRequiredFields = []string{constants.ProjectID, constants.ID}
 var UpdateRequiredFields []string
 var DeleteRequiredFields = []string{constants.ProjectID, constants.ID, EndpointServiceID}
-var ListRequiredFields = []string{constants.GroupID}
+var ListRequiredFields = []string{constants.ProjectID, EndpointServiceID}
 
 // Create handles the Create event from the Cloudformation service.
 func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
@@ -68,6 +73,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *errEvent, nil
 	}
 
+	if errEvent := ValidateProviderFields(currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
 	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 
 	if currentModel.EnforceConnectionSuccess == nil {
@@ -87,12 +96,13 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			return progress_events.GetFailedEventByResponse("Error getting Private Endpoint", response), nil
 		}
 
-		if IsTerminalStatus(*privateEndpoint.ConnectionStatus) {
+		status := GetEndpointStatus(privateEndpoint)
+		if IsTerminalStatus(status) {
 			if currentModel.EnforceConnectionSuccess != nil && *currentModel.EnforceConnectionSuccess &&
-				*privateEndpoint.ConnectionStatus == Rejected {
+				IsFailedStatus(status) {
 				return handler.ProgressEvent{
 					OperationStatus: handler.Failed,
-					Message:         fmt.Sprintf("Connection was Rejected : %s", *privateEndpoint.ErrorMessage),
+					Message:         fmt.Sprintf("Connection was %s : %s", status, privateEndpoint.GetErrorMessage()),
 					ResourceModel:   currentModel,
 				}, nil
 			}
@@ -100,11 +110,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			return handler.ProgressEvent{
 				OperationStatus: handler.Success,
 				Message:         "Create Success",
-				ResourceModel:   currentModel,
+				ResourceModel:   GetPrivateEndpointModel(privateEndpoint, currentModel),
 			}, nil
 		}
 
-		status := *privateEndpoint.ConnectionStatus
 		return handler.ProgressEvent{
 			OperationStatus:      handler.InProgress,
 			Message:              "Create in progress",
@@ -115,12 +124,8 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			}}, nil
 	}
 
-	endpointRequest := admin20231115014.CreateEndpointRequest{
-		Id: currentModel.Id,
-	}
-
 	privateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.CreatePrivateEndpoint(context.Background(), *currentModel.ProjectId,
-		CloudProvider, *currentModel.EndpointServiceId, &endpointRequest)
+		GetCloudProvider(currentModel), *currentModel.EndpointServiceId, NewCreateEndpointReq(currentModel))
 
 	_, response, err := privateEndpointRequest.Execute()
 	defer response.Body.Close()
@@ -148,7 +153,7 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 func getPrivateEndpoint(client *util.MongoDBClient, model *Model) (*admin20231115014.PrivateLinkEndpoint, *http.Response, error) {
 	privateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.GetPrivateEndpoint(context.Background(), *model.ProjectId,
-		CloudProvider, *model.Id, *model.EndpointServiceId)
+		GetCloudProvider(model), *model.Id, *model.EndpointServiceId)
 	privateEndpoint, response, err := privateEndpointRequest.Execute()
 
 	return privateEndpoint, response, err
@@ -176,19 +181,12 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 		return progress_events.GetFailedEventByResponse(fmt.Sprintf("READ: Error getting private endpoint: %s", err.Error()), response), nil
 	}
 
-	currentModel.completeByAtlasModel(*privateEndpoint)
-
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   currentModel,
+		ResourceModel:   GetPrivateEndpointModel(privateEndpoint, currentModel),
 	}, nil
 }
 
-func (m *Model) completeByAtlasModel(privateEndpoint admin20231115014.PrivateLinkEndpoint) {
-	m.ErrorMessage = privateEndpoint.ErrorMessage
-	m.ConnectionStatus = privateEndpoint.ConnectionStatus
-}
-
 // Update handles the Update event from the Cloudformation service.
 func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
 	return handler.ProgressEvent{}, errors.New("not implemented: Update")
@@ -234,7 +232,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	}
 
 	privateEndpointRequest := client.Atlas20231115014.PrivateEndpointServicesApi.DeletePrivateEndpoint(context.Background(), *currentModel.ProjectId,
-		CloudProvider, *currentModel.Id, *currentModel.EndpointServiceId)
+		GetCloudProvider(currentModel), *currentModel.Id, *currentModel.EndpointServiceId)
 	_, response, err := privateEndpointRequest.Execute()
 	defer response.Body.Close()
 	if err != nil {
@@ -255,5 +253,46 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 
 // List handles the List event from the Cloudformation service.
 func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	return handler.ProgressEvent{}, errors.New("not implemented: List")
+	setup()
+
+	if errEvent := validator.ValidateModel(ListRequiredFields, currentModel); errEvent != nil {
+		_, _ = logger.Warnf("Validation Error")
+		return *errEvent, nil
+	}
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	// Atlas doesn't list private endpoints, they are returned by ID in the endpoint service
+	provider := GetCloudProvider(currentModel)
+	service, response, err := client.Atlas20231115014.PrivateEndpointServicesApi.GetPrivateEndpointService(context.Background(), *currentModel.ProjectId,
+		provider, *currentModel.EndpointServiceId).Execute()
+	if err != nil {
+		return progress_events.GetFailedEventByResponse(fmt.Sprintf("LIST: Error getting private endpoint service: %s", err.Error()), response), nil
+	}
+
+	models := make([]interface{}, 0)
+	for _, endpointID := range GetEndpointIDs(service, provider) {
+		model := &Model{
+			Profile:           currentModel.Profile,
+			ProjectId:         currentModel.ProjectId,
+			EndpointServiceId: currentModel.EndpointServiceId,
+			CloudProvider:     util.Pointer(provider),
+			Id:                util.Pointer(endpointID),
+		}
+		privateEndpoint, response, err := getPrivateEndpoint(client, model)
+		if err != nil {
+			return progress_events.GetFailedEventByResponse(fmt.Sprintf("LIST: Error getting private endpoint: %s", err.Error()), response), nil
+		}
+		models = append(models, GetPrivateEndpointModel(privateEndpoint, model))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
 }
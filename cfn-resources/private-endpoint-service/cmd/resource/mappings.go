@@ -0,0 +1,80 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+)
+
+const WaitingForUserStatus = "WAITING_FOR_USER"
+
+// GetEndpointServiceModel fills the model with the endpoint service, including the properties specific to its cloud provider.
+func GetEndpointServiceModel(service *admin20231115014.EndpointService, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if service == nil {
+		return model
+	}
+
+	model.Id = service.Id
+	model.ErrorMessage = service.ErrorMessage
+	model.Status = service.Status
+	if service.CloudProvider != "" {
+		model.CloudProvider = util.Pointer(service.CloudProvider)
+	}
+	if model.Region == nil {
+		model.Region = service.RegionName
+	}
+
+	switch util.SafeString(model.CloudProvider) {
+	case constants.AZURE:
+		model.PrivateEndpoints = service.GetPrivateEndpoints()
+		model.PrivateLinkServiceName = service.PrivateLinkServiceName
+		model.PrivateLinkServiceResourceId = service.PrivateLinkServiceResourceId
+	case constants.GCP:
+		model.EndpointGroupNames = service.GetEndpointGroupNames()
+		model.ServiceAttachmentNames = service.GetServiceAttachmentNames()
+	default:
+		model.EndpointServiceName = service.EndpointServiceName
+		model.InterfaceEndpoints = service.GetInterfaceEndpoints()
+	}
+	return model
+}
+
+// IsServiceReady reports whether the endpoint service exposes what's needed to connect private endpoints to it:
+// the endpoint service name for AWS, the Private Link Service for Azure and the service attachments for GCP.
+func IsServiceReady(service *admin20231115014.EndpointService) bool {
+	status := service.GetStatus()
+	switch service.CloudProvider {
+	case constants.AZURE:
+		return (status == AvailableStatus || status == WaitingForUserStatus) && util.IsStringPresent(service.PrivateLinkServiceResourceId)
+	case constants.GCP:
+		return status == AvailableStatus && len(service.GetServiceAttachmentNames()) > 0
+	default:
+		return status == AvailableStatus && util.IsStringPresent(service.EndpointServiceName)
+	}
+}
+
+// IsServicePending reports whether Atlas is still provisioning the endpoint service.
+func IsServicePending(service *admin20231115014.EndpointService) bool {
+	status := service.GetStatus()
+	return status == InitiatingStatus || ((status == AvailableStatus || status == WaitingForUserStatus) && !IsServiceReady(service))
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/private-endpoint-service/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+const serviceID = "111111111111111111111111"
+
+func TestGetEndpointServiceModel(t *testing.T) {
+	tests := []struct {
+		input    *admin20231115014.EndpointService
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name: "AWS",
+			input: &admin20231115014.EndpointService{
+				CloudProvider:       constants.AWS,
+				Id:                  ptr.String(serviceID),
+				RegionName:          ptr.String("us-east-1"),
+				Status:              ptr.String(resource.AvailableStatus),
+				EndpointServiceName: ptr.String("com.amazonaws.vpce.us-east-1.vpce-svc-123"),
+				InterfaceEndpoints:  &[]string{"vpce-123"},
+			},
+			expected: &resource.Model{
+				CloudProvider:       ptr.String(constants.AWS),
+				Id:                  ptr.String(serviceID),
+				Region:              ptr.String("us-east-1"),
+				Status:              ptr.String(resource.AvailableStatus),
+				EndpointServiceName: ptr.String("com.amazonaws.vpce.us-east-1.vpce-svc-123"),
+				InterfaceEndpoints:  []string{"vpce-123"},
+			},
+		},
+		{
+			name: "Azure",
+			input: &admin20231115014.EndpointService{
+				CloudProvider:                constants.AZURE,
+				Id:                           ptr.String(serviceID),
+				RegionName:                   ptr.String("US_EAST_2"),
+				Status:                       ptr.String(resource.AvailableStatus),
+				PrivateEndpoints:             &[]string{"/subscriptions/1/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"},
+				PrivateLinkServiceName:       ptr.String("pls_123"),
+				PrivateLinkServiceResourceId: ptr.String("/subscriptions/2/resourceGroups/rg/providers/Microsoft.Network/privateLinkServices/pls_123"),
+			},
+			expected: &resource.Model{
+				CloudProvider:                ptr.String(constants.AZURE),
+				Id:                           ptr.String(serviceID),
+				Region:                       ptr.String("US_EAST_2"),
+				Status:                       ptr.String(resource.AvailableStatus),
+				PrivateEndpoints:             []string{"/subscriptions/1/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"},
+				PrivateLinkServiceName:       ptr.String("pls_123"),
+				PrivateLinkServiceResourceId: ptr.String("/subscriptions/2/resourceGroups/rg/providers/Microsoft.Network/privateLinkServices/pls_123"),
+			},
+		},
+		{
+			name: "GCP",
+			input: &admin20231115014.EndpointService{
+				CloudProvider:          constants.GCP,
+				Id:                     ptr.String(serviceID),
+				RegionName:             ptr.String("CENTRAL_US"),
+				Status:                 ptr.String(resource.AvailableStatus),
+				EndpointGroupNames:     &[]string{"group"},
+				ServiceAttachmentNames: &[]string{"projects/p/regions/us-central1/serviceAttachments/sa-1"},
+			},
+			expected: &resource.Model{
+				CloudProvider:          ptr.String(constants.GCP),
+				Id:                     ptr.String(serviceID),
+				Region:                 ptr.String("CENTRAL_US"),
+				Status:                 ptr.String(resource.AvailableStatus),
+				EndpointGroupNames:     []string{"group"},
+				ServiceAttachmentNames: []string{"projects/p/regions/us-central1/serviceAttachments/sa-1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.GetEndpointServiceModel(tt.input, nil))
+		})
+	}
+}
+
+func TestServiceStatus(t *testing.T) {
+	tests := []struct {
+		input           *admin20231115014.EndpointService
+		name            string
+		expectedReady   bool
+		expectedPending bool
+	}{
+		{
+			name:            "AWS initiating",
+			input:           &admin20231115014.EndpointService{CloudProvider: constants.AWS, Status: ptr.String(resource.InitiatingStatus)},
+			expectedReady:   false,
+			expectedPending: true,
+		},
+		{
+			name: "AWS available",
+			input: &admin20231115014.EndpointService{CloudProvider: constants.AWS, Status: ptr.String(resource.AvailableStatus),
+				EndpointServiceName: ptr.String("com.amazonaws.vpce.us-east-1.vpce-svc-123")},
+			expectedReady:   true,
+			expectedPending: false,
+		},
+		{
+			name: "Azure waiting for user",
+			input: &admin20231115014.EndpointService{CloudProvider: constants.AZURE, Status: ptr.String(resource.WaitingForUserStatus),
+				PrivateLinkServiceResourceId: ptr.String("/subscriptions/2/privateLinkServices/pls_123")},
+			expectedReady:   true,
+			expectedPending: false,
+		},
+		{
+			name:            "GCP available without service attachments",
+			input:           &admin20231115014.EndpointService{CloudProvider: constants.GCP, Status: ptr.String(resource.AvailableStatus)},
+			expectedReady:   false,
+			expectedPending: true,
+		},
+		{
+			name:            "Failed",
+			input:           &admin20231115014.EndpointService{CloudProvider: constants.GCP, Status: ptr.String("FAILED")},
+			expectedReady:   false,
+			expectedPending: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedReady, resource.IsServiceReady(tt.input))
+			assert.Equal(t, tt.expectedPending, resource.IsServicePending(tt.input))
+		})
+	}
+}
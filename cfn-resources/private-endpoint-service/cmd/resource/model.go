@@ -4,13 +4,18 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	Profile             *string  `json:",omitempty"`
-	Id                  *string  `json:",omitempty"`
-	EndpointServiceName *string  `json:",omitempty"`
-	ErrorMessage        *string  `json:",omitempty"`
-	Status              *string  `json:",omitempty"`
-	ProjectId           *string  `json:",omitempty"`
-	Region              *string  `json:",omitempty"`
-	InterfaceEndpoints  []string `json:",omitempty"`
-	CloudProvider       *string  `json:",omitempty"`
+	Profile                      *string  `json:",omitempty"`
+	Id                           *string  `json:",omitempty"`
+	EndpointServiceName          *string  `json:",omitempty"`
+	ErrorMessage                 *string  `json:",omitempty"`
+	Status                       *string  `json:",omitempty"`
+	ProjectId                    *string  `json:",omitempty"`
+	Region                       *string  `json:",omitempty"`
+	InterfaceEndpoints           []string `json:",omitempty"`
+	PrivateEndpoints             []string `json:",omitempty"`
+	PrivateLinkServiceName       *string  `json:",omitempty"`
+	PrivateLinkServiceResourceId *string  `json:",omitempty"`
+	EndpointGroupNames           []string `json:",omitempty"`
+	ServiceAttachmentNames       []string `json:",omitempty"`
+	CloudProvider                *string  `json:",omitempty"`
 }
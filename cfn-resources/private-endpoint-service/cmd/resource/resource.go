@@ -98,7 +98,7 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			response), nil
 	}
 
-	currentModel.completeByConnection(*privateEndpointResponse)
+	currentModel = GetEndpointServiceModel(privateEndpointResponse, currentModel)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
@@ -200,13 +200,12 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 
 	mm := make([]interface{}, 0, len(privateEndpointResponse))
 	for i := range privateEndpointResponse {
-		var m Model
-		m.completeByConnection(privateEndpointResponse[i])
-		m.Region = currentModel.Region
-		m.Profile = currentModel.Profile
-		m.ProjectId = currentModel.ProjectId
-		m.CloudProvider = currentModel.CloudProvider
-		mm = append(mm, m)
+		m := Model{
+			Profile:       currentModel.Profile,
+			ProjectId:     currentModel.ProjectId,
+			CloudProvider: currentModel.CloudProvider,
+		}
+		mm = append(mm, *GetEndpointServiceModel(&privateEndpointResponse[i], &m))
 	}
 
 	return handler.ProgressEvent{
@@ -235,14 +234,6 @@ func isCreating(req handler.Request) bool {
 	return callbackValue == ProgressStatusCreating
 }
 
-func (m *Model) completeByConnection(c admin20231115014.EndpointService) {
-	m.Id = c.Id
-	m.EndpointServiceName = c.EndpointServiceName
-	m.ErrorMessage = c.ErrorMessage
-	m.Status = c.Status
-	m.InterfaceEndpoints = c.GetInterfaceEndpoints()
-}
-
 type privateEndpointCreationCallBackContext struct {
 	StateName string
 	ID        string
@@ -286,7 +277,7 @@ func create(client *util.MongoDBClient, currentModel *Model) handler.ProgressEve
 			string(types.HandlerErrorCodeServiceInternalError))
 	}
 
-	currentModel.completeByConnection(*createPrivateEndpointResponse)
+	currentModel = GetEndpointServiceModel(createPrivateEndpointResponse, currentModel)
 	return progressevent.GetInProgressProgressEvent("Creating private endpoint service", callBackMap,
 		currentModel, 20)
 }
@@ -303,14 +294,19 @@ func validateCreationCompletion(client *util.MongoDBClient, currentModel *Model,
 			response)
 	}
 
-	currentModel.completeByConnection(*privateEndpointResponse)
+	currentModel = GetEndpointServiceModel(privateEndpointResponse, currentModel)
 
 	if privateEndpointResponse.Status == nil {
 		return progressevent.GetFailedEventByCode("Error getting private endpoint status : status null", string(types.HandlerErrorCodeServiceInternalError))
 	}
 
-	switch *privateEndpointResponse.Status {
-	case InitiatingStatus:
+	switch {
+	case IsServiceReady(privateEndpointResponse):
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         "Create Completed",
+			ResourceModel:   currentModel}
+	case IsServicePending(privateEndpointResponse):
 		callBackContext := privateEndpointCreationCallBackContext{
 			StateName: ProgressStatusCreating,
 			ID:        *privateEndpointResponse.Id,
@@ -324,11 +320,6 @@ func validateCreationCompletion(client *util.MongoDBClient, currentModel *Model,
 
 		return progressevent.GetInProgressProgressEvent("Private endpoint service initiating", callBackMap,
 			currentModel, 20)
-	case AvailableStatus:
-		return handler.ProgressEvent{
-			OperationStatus: handler.Success,
-			Message:         "Create Completed",
-			ResourceModel:   currentModel}
 	default:
 		return progressevent.GetFailedEventByCode(fmt.Sprintf("Error creating private endpoint in status : %s",
 			*privateEndpointResponse.Status),
@@ -0,0 +1,88 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// KnownLimitNames are the project limits that can be managed with the Atlas Admin API.
+var KnownLimitNames = []string{
+	"atlas.project.deployment.clusters",
+	"atlas.project.deployment.nodesPerPrivateLinkRegion",
+	"atlas.project.deployment.privateServiceConnectionsPerRegionGroup",
+	"atlas.project.deployment.privateServiceConnectionsSubnetMask",
+	"atlas.project.security.databaseAccess.customRoles",
+	"atlas.project.security.databaseAccess.users",
+	"atlas.project.security.networkAccess.crossRegionEntries",
+	"atlas.project.security.networkAccess.entries",
+	"dataFederation.bytesProcessed.query",
+	"dataFederation.bytesProcessed.daily",
+	"dataFederation.bytesProcessed.weekly",
+	"dataFederation.bytesProcessed.monthly",
+}
+
+func ValidateLimitName(limitName string) error {
+	if !util.Contains(KnownLimitNames, limitName) {
+		return fmt.Errorf("unsupported LimitName %q, must be one of: %s", limitName, strings.Join(KnownLimitNames, ", "))
+	}
+	return nil
+}
+
+// IsLimitSet reports whether the limit has been changed from its default. Deleting a limit resets it to the default
+// instead of removing it, so a limit at its default value is considered not to exist.
+func IsLimitSet(limit *admin.DataFederationLimit) bool {
+	if limit == nil {
+		return false
+	}
+	return limit.DefaultLimit == nil || limit.Value != *limit.DefaultLimit
+}
+
+func NewProjectLimitReq(model *Model) *admin.DataFederationLimit {
+	if model == nil {
+		return nil
+	}
+	return admin.NewDataFederationLimit(util.SafeString(model.LimitName), int64(util.SafeInt(model.Value)))
+}
+
+func GetProjectLimitModel(limit *admin.DataFederationLimit, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if limit == nil {
+		return model
+	}
+
+	model.LimitName = util.StringPtr(limit.Name)
+	model.Value = util.Pointer(int(limit.Value))
+	model.CurrentUsage = int64PtrToIntPtr(limit.CurrentUsage)
+	model.DefaultLimit = int64PtrToIntPtr(limit.DefaultLimit)
+	model.MaximumLimit = int64PtrToIntPtr(limit.MaximumLimit)
+	return model
+}
+
+func int64PtrToIntPtr(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+	return util.Pointer(int(*value))
+}
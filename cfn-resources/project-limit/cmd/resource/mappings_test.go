@@ -0,0 +1,152 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project-limit/cmd/resource"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const clustersLimit = "atlas.project.deployment.clusters"
+
+func TestValidateLimitName(t *testing.T) {
+	assert.NoError(t, resource.ValidateLimitName(clustersLimit))
+	assert.NoError(t, resource.ValidateLimitName("dataFederation.bytesProcessed.daily"))
+	assert.Error(t, resource.ValidateLimitName("atlas.project.deployment.unknown"))
+	assert.Error(t, resource.ValidateLimitName(""))
+}
+
+func TestNewProjectLimitReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin.DataFederationLimit
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "Name and Value",
+			input: &resource.Model{
+				ProjectId: ptr.String("65d6c4f9e3e5f0356a1b2c3d"),
+				LimitName: ptr.String(clustersLimit),
+				Value:     util.Pointer(50),
+			},
+			expected: &admin.DataFederationLimit{
+				Name:  clustersLimit,
+				Value: 50,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewProjectLimitReq(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetProjectLimitModel(t *testing.T) {
+	tests := []struct {
+		input    *admin.DataFederationLimit
+		expected *resource.Model
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "All Fields",
+			input: &admin.DataFederationLimit{
+				Name:         clustersLimit,
+				Value:        50,
+				CurrentUsage: util.Pointer(int64(3)),
+				DefaultLimit: util.Pointer(int64(25)),
+				MaximumLimit: util.Pointer(int64(100)),
+			},
+			expected: &resource.Model{
+				LimitName:    ptr.String(clustersLimit),
+				Value:        util.Pointer(50),
+				CurrentUsage: util.Pointer(3),
+				DefaultLimit: util.Pointer(25),
+				MaximumLimit: util.Pointer(100),
+			},
+		},
+		{
+			name: "Without usage",
+			input: &admin.DataFederationLimit{
+				Name:  "dataFederation.bytesProcessed.daily",
+				Value: 1000000000,
+			},
+			expected: &resource.Model{
+				LimitName: ptr.String("dataFederation.bytesProcessed.daily"),
+				Value:     util.Pointer(1000000000),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetProjectLimitModel(tt.input, nil)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestIsLimitSet(t *testing.T) {
+	tests := []struct {
+		input    *admin.DataFederationLimit
+		name     string
+		expected bool
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: false,
+		},
+		{
+			name:     "Default Value",
+			input:    &admin.DataFederationLimit{Name: clustersLimit, Value: 25, DefaultLimit: util.Pointer(int64(25))},
+			expected: false,
+		},
+		{
+			name:     "Custom Value",
+			input:    &admin.DataFederationLimit{Name: clustersLimit, Value: 50, DefaultLimit: util.Pointer(int64(25))},
+			expected: true,
+		},
+		{
+			name:     "Without Default",
+			input:    &admin.DataFederationLimit{Name: "dataFederation.bytesProcessed.daily", Value: 1000000000},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resource.IsLimitSet(tt.input))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.LimitName, constants.Value}
+var ReadRequiredFields = []string{constants.ProjectID, constants.LimitName}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.LimitName, constants.Value}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.LimitName}
+var ListRequiredFields = []string{constants.ProjectID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-project-limit")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	if currentModel.LimitName != nil {
+		if err := ValidateLimitName(*currentModel.LimitName); err != nil {
+			return nil, util.Pointer(progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)))
+		}
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	existingLimit, apiResp, err := conn.ProjectsApi.GetGroupLimit(context.Background(), *currentModel.LimitName, *currentModel.ProjectId).Execute()
+	if err == nil && IsLimitSet(existingLimit) {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("the limit %s is already set for the project", *currentModel.LimitName),
+			string(types.HandlerErrorCodeAlreadyExists)), nil
+	}
+	if err != nil && (apiResp == nil || apiResp.StatusCode != http.StatusNotFound) {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return setProjectLimit(conn, currentModel, constants.CREATE, "Create Completed")
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	projectID := currentModel.ProjectId
+	limitName := currentModel.LimitName
+	limit, apiResp, err := conn.ProjectsApi.GetGroupLimit(context.Background(), *limitName, *projectID).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+	if !IsLimitSet(limit) {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("the limit %s is not set for the project", *limitName),
+			string(types.HandlerErrorCodeNotFound)), nil
+	}
+
+	resourceModel := GetProjectLimitModel(limit, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	return setProjectLimit(conn, currentModel, constants.UPDATE, "Update Completed")
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	projectID := currentModel.ProjectId
+	limitName := currentModel.LimitName
+	if apiResp, err := conn.ProjectsApi.DeleteGroupLimit(context.Background(), *limitName, *projectID).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	limits, apiResp, err := conn.ProjectsApi.ListGroupLimits(context.Background(), *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	response := make([]interface{}, 0)
+	for i := range limits {
+		if !util.Contains(KnownLimitNames, limits[i].Name) || !IsLimitSet(&limits[i]) {
+			continue
+		}
+		model := GetProjectLimitModel(&limits[i], nil)
+		model.ProjectId = currentModel.ProjectId
+		model.Profile = currentModel.Profile
+
+		response = append(response, model)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  response,
+	}, nil
+}
+
+// setProjectLimit is shared by Create and Update since Atlas uses the same PATCH endpoint to set a limit.
+// The current limit is read first so that values above the maximum allowed are rejected before calling the API.
+func setProjectLimit(conn *admin.APIClient, currentModel *Model, method constants.CfnFunctions, message string) (handler.ProgressEvent, error) {
+	ctx := context.Background()
+
+	projectID := currentModel.ProjectId
+	limitName := currentModel.LimitName
+	existingLimit, apiResp, err := conn.ProjectsApi.GetGroupLimit(ctx, *limitName, *projectID).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	if defaultLimit := existingLimit.DefaultLimit; defaultLimit != nil && int64(*currentModel.Value) == *defaultLimit {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("Value %d is the default for %s, delete the resource to reset the limit", *currentModel.Value, *limitName),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	if maximumLimit := existingLimit.MaximumLimit; maximumLimit != nil && int64(*currentModel.Value) > *maximumLimit {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("Value %d exceeds the maximum allowed for %s: %d", *currentModel.Value, *limitName, *maximumLimit),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	limit, apiResp, err := conn.ProjectsApi.SetGroupLimit(ctx, *limitName, *projectID, NewProjectLimitReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	resourceModel := GetProjectLimitModel(limit, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         message,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
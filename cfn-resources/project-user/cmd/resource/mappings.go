@@ -0,0 +1,74 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+// PendingOrgMembershipStatus is the organization membership status of a user who was invited but hasn't joined yet.
+const PendingOrgMembershipStatus = "PENDING"
+
+// IsActiveOrgMember returns true when the organization users contain the user as an active member.
+func IsActiveOrgMember(users *admin.PaginatedOrgUser) bool {
+	for _, user := range users.GetResults() {
+		if user.OrgMembershipStatus != PendingOrgMembershipStatus {
+			return true
+		}
+	}
+	return false
+}
+
+func NewProjectUserReq(model *Model) *admin.GroupUserRequest {
+	return admin.NewGroupUserRequest(model.Roles, util.SafeString(model.Username))
+}
+
+func GetProjectUserModel(user *admin.GroupUserResponse, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+	}
+	if user != nil {
+		model.UserId = util.StringPtr(user.Id)
+		model.Username = util.StringPtr(user.Username)
+		model.Roles = user.Roles
+		model.OrgMembershipStatus = util.StringPtr(user.OrgMembershipStatus)
+	}
+	return model
+}
+
+// GetRoleChanges returns the roles to add and to remove so that the user is left with the desired roles.
+func GetRoleChanges(current, desired []string) (toAdd, toRemove []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, role := range current {
+		currentSet[role] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, role := range desired {
+		desiredSet[role] = true
+		if !currentSet[role] {
+			toAdd = append(toAdd, role)
+		}
+	}
+	for _, role := range current {
+		if !desiredSet[role] {
+			toRemove = append(toRemove, role)
+		}
+	}
+	return toAdd, toRemove
+}
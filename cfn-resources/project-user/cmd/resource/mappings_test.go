@@ -0,0 +1,138 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/project-user/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProjectUserModel(t *testing.T) {
+	tests := []struct {
+		user         *admin.GroupUserResponse
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name: "Active User",
+			user: &admin.GroupUserResponse{
+				Id:                  "111111111111111111111111",
+				Username:            "user@example.com",
+				Roles:               []string{"GROUP_READ_ONLY"},
+				OrgMembershipStatus: "ACTIVE",
+			},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("222222222222222222222222"),
+			},
+			expected: &resource.Model{
+				Profile:             ptr.String("default"),
+				ProjectId:           ptr.String("222222222222222222222222"),
+				UserId:              ptr.String("111111111111111111111111"),
+				Username:            ptr.String("user@example.com"),
+				Roles:               []string{"GROUP_READ_ONLY"},
+				OrgMembershipStatus: ptr.String("ACTIVE"),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetProjectUserModel(tc.user, tc.currentModel))
+		})
+	}
+}
+
+func TestIsActiveOrgMember(t *testing.T) {
+	tests := []struct {
+		users    *admin.PaginatedOrgUser
+		name     string
+		expected bool
+	}{
+		{
+			name:     "Nil Input",
+			expected: false,
+		},
+		{
+			name:     "Not A Member",
+			users:    &admin.PaginatedOrgUser{Results: &[]admin.OrgUserResponse{}},
+			expected: false,
+		},
+		{
+			name: "Pending Member",
+			users: &admin.PaginatedOrgUser{Results: &[]admin.OrgUserResponse{
+				{Username: "user@example.com", OrgMembershipStatus: "PENDING"},
+			}},
+			expected: false,
+		},
+		{
+			name: "Active Member",
+			users: &admin.PaginatedOrgUser{Results: &[]admin.OrgUserResponse{
+				{Username: "user@example.com", OrgMembershipStatus: "ACTIVE"},
+			}},
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.IsActiveOrgMember(tc.users))
+		})
+	}
+}
+
+func TestGetRoleChanges(t *testing.T) {
+	tests := []struct {
+		name             string
+		current          []string
+		desired          []string
+		expectedToAdd    []string
+		expectedToRemove []string
+	}{
+		{
+			name:    "No Changes",
+			current: []string{"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"},
+			desired: []string{"GROUP_DATA_ACCESS_READ_ONLY", "GROUP_READ_ONLY"},
+		},
+		{
+			name:          "Add Role",
+			current:       []string{"GROUP_READ_ONLY"},
+			desired:       []string{"GROUP_READ_ONLY", "GROUP_CLUSTER_MANAGER"},
+			expectedToAdd: []string{"GROUP_CLUSTER_MANAGER"},
+		},
+		{
+			name:             "Replace Role",
+			current:          []string{"GROUP_READ_ONLY"},
+			desired:          []string{"GROUP_OWNER"},
+			expectedToAdd:    []string{"GROUP_OWNER"},
+			expectedToRemove: []string{"GROUP_READ_ONLY"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			toAdd, toRemove := resource.GetRoleChanges(tc.current, tc.desired)
+			assert.Equal(t, tc.expectedToAdd, toAdd)
+			assert.Equal(t, tc.expectedToRemove, toRemove)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const defaultItemsPerPage = 100
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.Username, constants.Roles}
+var ReadRequiredFields = []string{constants.ProjectID, constants.CloudUserID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.CloudUserID, constants.Roles}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.CloudUserID}
+var ListRequiredFields = []string{constants.ProjectID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-project-user")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create adds the user to the project. Users who aren't active members of the organization would receive an
+// invitation instead, so the membership is checked first and the request fails before anything is changed.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	username := *currentModel.Username
+	project, apiResp, err := conn.ProjectsApi.GetGroup(ctx, projectID).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	users, apiResp, err := conn.MongoDBCloudUsersApi.ListOrgUsers(ctx, project.OrgId).Username(username).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+	if !IsActiveOrgMember(users) {
+		return progress_events.GetFailedEventByCode(
+			fmt.Sprintf("user %s is not an active member of the organization, use MongoDB::Atlas::ProjectInvitation to invite them", username),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	user, apiResp, err := conn.MongoDBCloudUsersApi.AddGroupUsers(ctx, projectID, NewProjectUserReq(currentModel)).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   GetProjectUserModel(user, currentModel),
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	user, apiResp, err := conn.MongoDBCloudUsersApi.GetGroupUser(context.Background(), *currentModel.ProjectId, *currentModel.UserId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetProjectUserModel(user, currentModel),
+	}, nil
+}
+
+// Update adds the missing roles before removing the extra ones, so the user always keeps at least one project role.
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	userID := *currentModel.UserId
+	user, apiResp, err := conn.MongoDBCloudUsersApi.GetGroupUser(ctx, projectID, userID).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	toAdd, toRemove := GetRoleChanges(user.Roles, currentModel.Roles)
+	for _, role := range toAdd {
+		user, apiResp, err = conn.MongoDBCloudUsersApi.AddGroupUserRole(ctx, projectID, userID, admin.NewAddOrRemoveGroupRole(role)).Execute()
+		if err != nil {
+			return handleError(apiResp, constants.UPDATE, err)
+		}
+	}
+	for _, role := range toRemove {
+		user, apiResp, err = conn.MongoDBCloudUsersApi.RemoveGroupUserRole(ctx, projectID, userID, admin.NewAddOrRemoveGroupRole(role)).Execute()
+		if err != nil {
+			return handleError(apiResp, constants.UPDATE, err)
+		}
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   GetProjectUserModel(user, currentModel),
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if apiResp, err := conn.MongoDBCloudUsersApi.RemoveGroupUser(context.Background(), *currentModel.ProjectId, *currentModel.UserId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	users, apiResp, err := getAllProjectUsers(context.Background(), conn, *currentModel.ProjectId)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	models := make([]any, 0, len(users))
+	for i := range users {
+		models = append(models, GetProjectUserModel(&users[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func getAllProjectUsers(ctx context.Context, conn *admin.APIClient, projectID string) ([]admin.GroupUserResponse, *http.Response, error) {
+	users := make([]admin.GroupUserResponse, 0)
+	for pageNum := 1; ; pageNum++ {
+		page, apiResp, err := conn.MongoDBCloudUsersApi.ListGroupUsers(ctx, projectID).
+			PageNum(pageNum).ItemsPerPage(defaultItemsPerPage).Execute()
+		if err != nil {
+			return nil, apiResp, err
+		}
+		results := page.GetResults()
+		users = append(users, results...)
+		if len(results) == 0 || page.GetTotalCount() <= len(users) {
+			return users, nil, nil
+		}
+	}
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
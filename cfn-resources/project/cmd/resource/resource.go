@@ -192,7 +192,8 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (event h
 		}
 	}
 
-	if currentModel.ProjectTeams != nil {
+	// teams removed from ProjectTeams are unassigned, teams that were never declared in ProjectTeams are left as is
+	if currentModel.ProjectTeams != nil || prevModel.ProjectTeams != nil {
 		teamsAssigned, _, err := atlasV2.TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
 		if err != nil {
 			return handler.ProgressEvent{
@@ -201,7 +202,7 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (event h
 				HandlerErrorCode: string(types.HandlerErrorCodeInvalidRequest)}, nil
 		}
 		if teamsAssigned != nil && teamsAssigned.Results != nil {
-			errorMessage, err := changeProjectTeams(*atlasV2, currentModel, teamsAssigned.GetResults())
+			errorMessage, err := changeProjectTeams(*atlasV2, currentModel, prevModel, teamsAssigned.GetResults())
 			if err != nil {
 				return handler.ProgressEvent{
 					OperationStatus:  handler.Failed,
@@ -385,19 +386,35 @@ func readProjectSettings(atlasV2 *admin20231115014.APIClient, id string, current
 		IsExtendedStorageSizesEnabled:               projectSettings.IsExtendedStorageSizesEnabled,
 	}
 
+	currentModel.ProjectTeams = GetManagedTeams(currentModel.ProjectTeams, teamsAssigned.GetResults())
+	currentModel.ProjectApiKeys = nil // hack: cfn test. Extra APIKey(default) getting added and cfn test fails.
+	return handler.ProgressEvent{}, currentModel, err
+}
+
+// GetManagedTeams returns the teams assigned to the project that are declared in ProjectTeams. Assignments managed
+// outside of the project, e.g. with MongoDB::Atlas::TeamProjectAssignment, are ignored.
+func GetManagedTeams(declaredTeams []ProjectTeam, assignedTeams []admin20231115014.TeamRole) []ProjectTeam {
 	var teams []ProjectTeam
-	for _, team := range teamsAssigned.GetResults() {
-		if util.IsStringPresent(team.TeamId) {
+	for _, team := range assignedTeams {
+		if util.IsStringPresent(team.TeamId) && containsTeam(declaredTeams, team.TeamId) {
 			teams = append(teams, ProjectTeam{TeamId: team.TeamId, RoleNames: team.GetRoleNames()})
 		}
 	}
+	return teams
+}
 
-	currentModel.ProjectTeams = teams
-	currentModel.ProjectApiKeys = nil // hack: cfn test. Extra APIKey(default) getting added and cfn test fails.
-	return handler.ProgressEvent{}, currentModel, err
+func containsTeam(teams []ProjectTeam, teamID *string) bool {
+	for i := range teams {
+		if util.AreStringPtrEqual(teams[i].TeamId, teamID) {
+			return true
+		}
+	}
+	return false
 }
 
-func getChangeInTeams(currentTeams []ProjectTeam, oTeams []admin20231115014.TeamRole) (newTeams []admin20231115014.TeamRole,
+// GetChangeInTeams compares the declared teams with the teams assigned to the project. Only the assigned teams that
+// were previously declared are removed, so assignments managed outside of the project are left as is.
+func GetChangeInTeams(currentTeams, previousTeams []ProjectTeam, oTeams []admin20231115014.TeamRole) (newTeams []admin20231115014.TeamRole,
 	changedTeams []admin20231115014.TeamRole, removeTeams []admin20231115014.TeamRole) {
 	for i := range currentTeams {
 		nTeam := currentTeams[i]
@@ -417,17 +434,8 @@ func getChangeInTeams(currentTeams []ProjectTeam, oTeams []admin20231115014.Team
 	}
 
 	for _, oTeam := range oTeams {
-		if util.IsStringPresent(oTeam.TeamId) {
-			matched := false
-			for _, nTeam := range currentTeams {
-				if util.AreStringPtrEqual(nTeam.TeamId, oTeam.TeamId) {
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				removeTeams = append(removeTeams, admin20231115014.TeamRole{TeamId: oTeam.TeamId, RoleNames: oTeam.RoleNames})
-			}
+		if util.IsStringPresent(oTeam.TeamId) && containsTeam(previousTeams, oTeam.TeamId) && !containsTeam(currentTeams, oTeam.TeamId) {
+			removeTeams = append(removeTeams, admin20231115014.TeamRole{TeamId: oTeam.TeamId, RoleNames: oTeam.RoleNames})
 		}
 	}
 	return newTeams, changedTeams, removeTeams
@@ -487,8 +495,8 @@ func GetChangeInAPIKeys(currentKeys []ProjectApiKey, previousKeys []ProjectApiKe
 	return newKeys, changedKeys, removeKeys
 }
 
-func changeProjectTeams(atlasV2 admin20231115014.APIClient, currentModel *Model, newTeams []admin20231115014.TeamRole) (errorMessage string, err error) {
-	newTeams, changedTeams, removeTeams := getChangeInTeams(currentModel.ProjectTeams, newTeams)
+func changeProjectTeams(atlasV2 admin20231115014.APIClient, currentModel, prevModel *Model, newTeams []admin20231115014.TeamRole) (errorMessage string, err error) {
+	newTeams, changedTeams, removeTeams := GetChangeInTeams(currentModel.ProjectTeams, prevModel.ProjectTeams, newTeams)
 	projectID := *currentModel.Id
 	for _, team := range removeTeams {
 		_, err = atlasV2.TeamsApi.RemoveProjectTeam(context.Background(), projectID, util.SafeString(team.TeamId)).Execute()
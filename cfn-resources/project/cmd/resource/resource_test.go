@@ -20,6 +20,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/project/cmd/resource"
+	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
 )
 
 func TestGetChangeInAPIKeys_AddedButNotChangedOrRemoved(t *testing.T) {
@@ -140,3 +141,47 @@ func TestGetChangeInAPIKeys_NoneAddedChangedOrRemoved(t *testing.T) {
 		t.Errorf("Test case failed. No new, changed, or removed keys expected.")
 	}
 }
+
+func TestGetChangeInTeams_ExternallyManagedTeamsAreKept(t *testing.T) {
+	currentTeams := []resource.ProjectTeam{
+		{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_OWNER"}},
+	}
+	previousTeams := []resource.ProjectTeam{
+		{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_READ_ONLY"}},
+		{TeamId: aws.String("team2"), RoleNames: []string{"GROUP_READ_ONLY"}},
+	}
+	assignedTeams := []admin20231115014.TeamRole{
+		{TeamId: aws.String("team1"), RoleNames: &[]string{"GROUP_READ_ONLY"}},
+		{TeamId: aws.String("team2"), RoleNames: &[]string{"GROUP_READ_ONLY"}},
+		{TeamId: aws.String("external"), RoleNames: &[]string{"GROUP_CLUSTER_MANAGER"}},
+	}
+
+	newTeams, changedTeams, removeTeams := resource.GetChangeInTeams(currentTeams, previousTeams, assignedTeams)
+
+	if len(newTeams) > 0 {
+		t.Errorf("Test case failed. Expected no new teams, got %v", newTeams)
+	}
+	if len(changedTeams) != 1 || *changedTeams[0].TeamId != "team1" {
+		t.Errorf("Test case failed. Expected team1 to be changed, got %v", changedTeams)
+	}
+	if len(removeTeams) != 1 || *removeTeams[0].TeamId != "team2" {
+		t.Errorf("Test case failed. Expected only team2 to be removed, got %v", removeTeams)
+	}
+}
+
+func TestGetManagedTeams(t *testing.T) {
+	declaredTeams := []resource.ProjectTeam{
+		{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_OWNER"}},
+	}
+	assignedTeams := []admin20231115014.TeamRole{
+		{TeamId: aws.String("team1"), RoleNames: &[]string{"GROUP_READ_ONLY"}},
+		{TeamId: aws.String("external"), RoleNames: &[]string{"GROUP_CLUSTER_MANAGER"}},
+	}
+
+	expected := []resource.ProjectTeam{
+		{TeamId: aws.String("team1"), RoleNames: []string{"GROUP_READ_ONLY"}},
+	}
+	if teams := resource.GetManagedTeams(declaredTeams, assignedTeams); !reflect.DeepEqual(teams, expected) {
+		t.Errorf("Test case failed. Expected only the declared teams, got %v", teams)
+	}
+}
@@ -15,12 +15,12 @@
 package resource
 
 import (
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 )
 
-func GetStreamConnectionModel(streamsConn *admin20231115014.StreamsConnection, currentModel *Model) *Model {
+func GetStreamConnectionModel(streamsConn *admin.StreamsConnection, currentModel *Model) *Model {
 	model := new(Model)
 
 	if currentModel != nil {
@@ -42,10 +42,20 @@ func GetStreamConnectionModel(streamsConn *admin20231115014.StreamsConnection, c
 		model.Config = *streamsConn.Config
 	}
 
+	model.Networking = NewModelNetworking(streamsConn.Networking)
+
+	model.Url = streamsConn.Url
+
+	if streamsConn.Headers != nil {
+		model.Headers = *streamsConn.Headers
+	}
+
+	model.Aws = NewModelAws(streamsConn.Aws)
+
 	return model
 }
 
-func NewModelDBRoleToExecute(dbRole *admin20231115014.DBRoleToExecute) *DBRoleToExecute {
+func NewModelDBRoleToExecute(dbRole *admin.DBRoleToExecute) *DBRoleToExecute {
 	if dbRole == nil {
 		return nil
 	}
@@ -56,7 +66,7 @@ func NewModelDBRoleToExecute(dbRole *admin20231115014.DBRoleToExecute) *DBRoleTo
 	}
 }
 
-func NewModelAuthentication(authentication *admin20231115014.StreamsKafkaAuthentication) *StreamsKafkaAuthentication {
+func NewModelAuthentication(authentication *admin.StreamsKafkaAuthentication) *StreamsKafkaAuthentication {
 	if authentication == nil {
 		return nil
 	}
@@ -68,7 +78,7 @@ func NewModelAuthentication(authentication *admin20231115014.StreamsKafkaAuthent
 	}
 }
 
-func NewModelSecurity(security *admin20231115014.StreamsKafkaSecurity) *StreamsKafkaSecurity {
+func NewModelSecurity(security *admin.StreamsKafkaSecurity) *StreamsKafkaSecurity {
 	if security == nil {
 		return nil
 	}
@@ -79,8 +89,31 @@ func NewModelSecurity(security *admin20231115014.StreamsKafkaSecurity) *StreamsK
 	}
 }
 
-func newStreamConnectionReq(model *Model) *admin20231115014.StreamsConnection {
-	streamConnReq := admin20231115014.StreamsConnection{
+func NewModelNetworking(networking *admin.StreamsKafkaNetworking) *StreamsKafkaNetworking {
+	if networking == nil || networking.Access == nil {
+		return nil
+	}
+
+	return &StreamsKafkaNetworking{
+		Access: &StreamsKafkaNetworkingAccess{
+			Type:         networking.Access.Type,
+			ConnectionId: networking.Access.ConnectionId,
+		},
+	}
+}
+
+func NewModelAws(aws *admin.StreamsAWSConnectionConfig) *StreamsAWSConnectionConfig {
+	if aws == nil {
+		return nil
+	}
+
+	return &StreamsAWSConnectionConfig{
+		RoleArn: aws.RoleArn,
+	}
+}
+
+func newStreamConnectionReq(model *Model) *admin.StreamsConnection {
+	streamConnReq := admin.StreamsConnection{
 		Name: model.ConnectionName,
 		Type: model.Type,
 	}
@@ -98,41 +131,78 @@ func newStreamConnectionReq(model *Model) *admin20231115014.StreamsConnection {
 		if model.Config != nil {
 			streamConnReq.Config = &model.Config
 		}
+
+		streamConnReq.Networking = newStreamsKafkaNetworking(model.Networking)
+	}
+
+	if util.SafeString(streamConnReq.Type) == HTTPSConnectionType {
+		streamConnReq.Url = model.Url
+
+		if model.Headers != nil {
+			streamConnReq.Headers = &model.Headers
+		}
+	}
+
+	if util.SafeString(streamConnReq.Type) == AWSLambdaConnectionType && model.Aws != nil {
+		streamConnReq.Aws = &admin.StreamsAWSConnectionConfig{
+			RoleArn: model.Aws.RoleArn,
+		}
 	}
 
 	return &streamConnReq
 }
 
-func NewDBRoleToExecute(dbRoleToExecuteModel *DBRoleToExecute) *admin20231115014.DBRoleToExecute {
+func NewDBRoleToExecute(dbRoleToExecuteModel *DBRoleToExecute) *admin.DBRoleToExecute {
 	if dbRoleToExecuteModel == nil {
 		return nil
 	}
 
-	return &admin20231115014.DBRoleToExecute{
+	return &admin.DBRoleToExecute{
 		Role: dbRoleToExecuteModel.Role,
 		Type: dbRoleToExecuteModel.Type,
 	}
 }
 
-func newStreamsKafkaSecurity(securityModel *StreamsKafkaSecurity) *admin20231115014.StreamsKafkaSecurity {
+func newStreamsKafkaSecurity(securityModel *StreamsKafkaSecurity) *admin.StreamsKafkaSecurity {
 	if securityModel == nil {
 		return nil
 	}
 
-	return &admin20231115014.StreamsKafkaSecurity{
+	return &admin.StreamsKafkaSecurity{
 		BrokerPublicCertificate: securityModel.BrokerPublicCertificate,
 		Protocol:                securityModel.Protocol,
 	}
 }
 
-func newStreamsKafkaAuthentication(authenticationModel *StreamsKafkaAuthentication) *admin20231115014.StreamsKafkaAuthentication {
+func newStreamsKafkaAuthentication(authenticationModel *StreamsKafkaAuthentication) *admin.StreamsKafkaAuthentication {
 	if authenticationModel == nil {
 		return nil
 	}
 
-	return &admin20231115014.StreamsKafkaAuthentication{
+	return &admin.StreamsKafkaAuthentication{
 		Mechanism: authenticationModel.Mechanism,
 		Password:  authenticationModel.Password,
 		Username:  authenticationModel.Username,
 	}
 }
+
+func newStreamsKafkaNetworking(networkingModel *StreamsKafkaNetworking) *admin.StreamsKafkaNetworking {
+	if networkingModel == nil || networkingModel.Access == nil {
+		return nil
+	}
+
+	access := &admin.StreamsKafkaNetworkingAccess{
+		Type: networkingModel.Access.Type,
+	}
+	if isPrivateLinkNetworking(networkingModel) {
+		access.ConnectionId = networkingModel.Access.ConnectionId
+	}
+
+	return &admin.StreamsKafkaNetworking{
+		Access: access,
+	}
+}
+
+func isPrivateLinkNetworking(networkingModel *StreamsKafkaNetworking) bool {
+	return networkingModel != nil && networkingModel.Access != nil && util.SafeString(networkingModel.Access.Type) == PrivateLinkNetworkingType
+}
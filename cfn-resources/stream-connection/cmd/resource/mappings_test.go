@@ -17,7 +17,7 @@ package resource_test
 import (
 	"testing"
 
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/aws/smithy-go/ptr"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/stream-connection/cmd/resource"
@@ -26,7 +26,7 @@ import (
 
 func TestNewModelDBRoleToExecute(t *testing.T) {
 	tests := []struct {
-		input    *admin20231115014.DBRoleToExecute
+		input    *admin.DBRoleToExecute
 		expected *resource.DBRoleToExecute
 		name     string
 	}{
@@ -37,7 +37,7 @@ func TestNewModelDBRoleToExecute(t *testing.T) {
 		},
 		{
 			name: "Valid Input",
-			input: &admin20231115014.DBRoleToExecute{
+			input: &admin.DBRoleToExecute{
 				Role: ptr.String("readWrite"),
 				Type: ptr.String("BUILT_IN"),
 			},
@@ -58,7 +58,7 @@ func TestNewModelDBRoleToExecute(t *testing.T) {
 
 func TestNewModelAuthentication(t *testing.T) {
 	tests := []struct {
-		input    *admin20231115014.StreamsKafkaAuthentication
+		input    *admin.StreamsKafkaAuthentication
 		expected *resource.StreamsKafkaAuthentication
 		name     string
 	}{
@@ -69,7 +69,7 @@ func TestNewModelAuthentication(t *testing.T) {
 		},
 		{
 			name: "Valid Input",
-			input: &admin20231115014.StreamsKafkaAuthentication{
+			input: &admin.StreamsKafkaAuthentication{
 				Mechanism: ptr.String("PLAIN"),
 				Username:  ptr.String("testuser111"),
 				Password:  ptr.String("testpassword"),
@@ -92,7 +92,7 @@ func TestNewModelAuthentication(t *testing.T) {
 
 func TestNewModelSecurity(t *testing.T) {
 	tests := []struct {
-		input    *admin20231115014.StreamsKafkaSecurity
+		input    *admin.StreamsKafkaSecurity
 		expected *resource.StreamsKafkaSecurity
 		name     string
 	}{
@@ -103,7 +103,7 @@ func TestNewModelSecurity(t *testing.T) {
 		},
 		{
 			name: "Valid Input",
-			input: &admin20231115014.StreamsKafkaSecurity{
+			input: &admin.StreamsKafkaSecurity{
 				BrokerPublicCertificate: ptr.String("testcert"),
 				Protocol:                ptr.String("SSL"),
 			},
@@ -125,7 +125,7 @@ func TestNewModelSecurity(t *testing.T) {
 func TestNewDBRoleToExecute(t *testing.T) {
 	tests := []struct {
 		input    *resource.DBRoleToExecute
-		expected *admin20231115014.DBRoleToExecute
+		expected *admin.DBRoleToExecute
 		name     string
 	}{
 		{
@@ -139,7 +139,7 @@ func TestNewDBRoleToExecute(t *testing.T) {
 				Role: ptr.String("customroleadmin"),
 				Type: ptr.String("CUSTOM"),
 			},
-			expected: &admin20231115014.DBRoleToExecute{
+			expected: &admin.DBRoleToExecute{
 				Role: ptr.String("customroleadmin"),
 				Type: ptr.String("CUSTOM"),
 			},
@@ -155,16 +155,16 @@ func TestNewDBRoleToExecute(t *testing.T) {
 }
 
 func TestGetStreamConnectionKafkaTypeModel(t *testing.T) {
-	streamsConnKafka := &admin20231115014.StreamsConnection{
+	streamsConnKafka := &admin.StreamsConnection{
 		Name:             ptr.String("TestConnection"),
 		Type:             ptr.String("Kafka"),
 		BootstrapServers: ptr.String("local.example.com:9192"),
-		Authentication: &admin20231115014.StreamsKafkaAuthentication{
+		Authentication: &admin.StreamsKafkaAuthentication{
 			Mechanism: ptr.String("PLAIN"),
 			Username:  ptr.String("user1"),
 			Password:  ptr.String("passwrd"),
 		},
-		Security: &admin20231115014.StreamsKafkaSecurity{
+		Security: &admin.StreamsKafkaSecurity{
 			BrokerPublicCertificate: ptr.String("cert1"),
 			Protocol:                ptr.String("SSL"),
 		},
@@ -217,11 +217,11 @@ func TestGetStreamConnectionKafkaTypeModel(t *testing.T) {
 }
 
 func TestGetStreamConnectionClusterTypeModel(t *testing.T) {
-	streamsConnKafka := &admin20231115014.StreamsConnection{
+	streamsConnKafka := &admin.StreamsConnection{
 		Name:        ptr.String("TestConnection"),
 		Type:        ptr.String("Cluster"),
 		ClusterName: ptr.String("TestCluster"),
-		DbRoleToExecute: &admin20231115014.DBRoleToExecute{
+		DbRoleToExecute: &admin.DBRoleToExecute{
 			Role: ptr.String("admin"),
 			Type: ptr.String("Custom"),
 		},
@@ -264,7 +264,7 @@ func TestGetStreamConnectionClusterTypeModel(t *testing.T) {
 }
 
 func TestGetStreamConnectionSampleTypeModel(t *testing.T) {
-	streamsConnSample := &admin20231115014.StreamsConnection{
+	streamsConnSample := &admin.StreamsConnection{
 		Name: ptr.String("sample_stream_solar"),
 		Type: ptr.String("Sample"),
 	}
@@ -308,3 +308,142 @@ func TestGetStreamConnectionSampleTypeModel(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStreamConnectionHTTPSTypeModel(t *testing.T) {
+	streamsConnHTTPS := &admin.StreamsConnection{
+		Name:    ptr.String("TestConnection"),
+		Type:    ptr.String(resource.HTTPSConnectionType),
+		Url:     ptr.String("https://example.com/webhook"),
+		Headers: &map[string]string{"Authorization": "Bearer token"},
+	}
+
+	result := resource.GetStreamConnectionModel(streamsConnHTTPS, nil)
+
+	assert.Equal(t, *streamsConnHTTPS.Url, *result.Url)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, result.Headers)
+	assert.Nil(t, result.Aws)
+	assert.Nil(t, result.Networking)
+}
+
+func TestGetStreamConnectionAWSLambdaTypeModel(t *testing.T) {
+	streamsConnLambda := &admin.StreamsConnection{
+		Name: ptr.String("TestConnection"),
+		Type: ptr.String(resource.AWSLambdaConnectionType),
+		Aws: &admin.StreamsAWSConnectionConfig{
+			RoleArn: ptr.String("arn:aws:iam::123456789012:role/lambda-role"),
+		},
+	}
+
+	result := resource.GetStreamConnectionModel(streamsConnLambda, nil)
+
+	assert.Equal(t, &resource.StreamsAWSConnectionConfig{RoleArn: ptr.String("arn:aws:iam::123456789012:role/lambda-role")}, result.Aws)
+	assert.Nil(t, result.Url)
+}
+
+func TestNewModelNetworking(t *testing.T) {
+	tests := []struct {
+		input    *admin.StreamsKafkaNetworking
+		expected *resource.StreamsKafkaNetworking
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "Private Link",
+			input: &admin.StreamsKafkaNetworking{
+				Access: &admin.StreamsKafkaNetworkingAccess{
+					Type:         ptr.String(resource.PrivateLinkNetworkingType),
+					ConnectionId: ptr.String("65d6c4f9e3e5f0356a1b2c3d"),
+				},
+			},
+			expected: &resource.StreamsKafkaNetworking{
+				Access: &resource.StreamsKafkaNetworkingAccess{
+					Type:         ptr.String(resource.PrivateLinkNetworkingType),
+					ConnectionId: ptr.String("65d6c4f9e3e5f0356a1b2c3d"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewModelNetworking(tt.input)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestValidateTypeFields(t *testing.T) {
+	kafkaModel := func(networking *resource.StreamsKafkaNetworking) *resource.Model {
+		return &resource.Model{
+			Type:             ptr.String(resource.KafkaConnectionType),
+			BootstrapServers: ptr.String("local.example.com:9192"),
+			Authentication:   &resource.StreamsKafkaAuthentication{Mechanism: ptr.String("PLAIN")},
+			Security:         &resource.StreamsKafkaSecurity{Protocol: ptr.String("SSL")},
+			Networking:       networking,
+		}
+	}
+	tests := []struct {
+		input   *resource.Model
+		name    string
+		wantErr bool
+	}{
+		{
+			name:  "Sample",
+			input: &resource.Model{Type: ptr.String(resource.SampleConnectionType)},
+		},
+		{
+			name:    "Cluster without ClusterName",
+			input:   &resource.Model{Type: ptr.String(resource.ClusterConnectionType)},
+			wantErr: true,
+		},
+		{
+			name:  "Https",
+			input: &resource.Model{Type: ptr.String(resource.HTTPSConnectionType), Url: ptr.String("https://example.com")},
+		},
+		{
+			name:    "Https without Url",
+			input:   &resource.Model{Type: ptr.String(resource.HTTPSConnectionType)},
+			wantErr: true,
+		},
+		{
+			name:    "AWSLambda without RoleArn",
+			input:   &resource.Model{Type: ptr.String(resource.AWSLambdaConnectionType), Aws: &resource.StreamsAWSConnectionConfig{}},
+			wantErr: true,
+		},
+		{
+			name:  "Kafka public",
+			input: kafkaModel(nil),
+		},
+		{
+			name: "Kafka private link without ConnectionId",
+			input: kafkaModel(&resource.StreamsKafkaNetworking{
+				Access: &resource.StreamsKafkaNetworkingAccess{Type: ptr.String(resource.PrivateLinkNetworkingType)},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "Kafka private link",
+			input: kafkaModel(&resource.StreamsKafkaNetworking{
+				Access: &resource.StreamsKafkaNetworkingAccess{
+					Type:         ptr.String(resource.PrivateLinkNetworkingType),
+					ConnectionId: ptr.String("65d6c4f9e3e5f0356a1b2c3d"),
+				},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errEvent := resource.ValidateTypeFields(tt.input)
+			if tt.wantErr {
+				assert.NotNil(t, errEvent)
+				return
+			}
+			assert.Nil(t, errEvent)
+		})
+	}
+}
@@ -15,6 +15,10 @@ type Model struct {
 	BootstrapServers *string                     `json:",omitempty"`
 	Security         *StreamsKafkaSecurity       `json:",omitempty"`
 	Config           map[string]string           `json:",omitempty"`
+	Networking       *StreamsKafkaNetworking     `json:",omitempty"`
+	Url              *string                     `json:",omitempty"`
+	Headers          map[string]string           `json:",omitempty"`
+	Aws              *StreamsAWSConnectionConfig `json:",omitempty"`
 }
 
 // DBRoleToExecute is autogenerated from the json schema
@@ -35,3 +39,19 @@ type StreamsKafkaSecurity struct {
 	BrokerPublicCertificate *string `json:",omitempty"`
 	Protocol                *string `json:",omitempty"`
 }
+
+// StreamsKafkaNetworking is autogenerated from the json schema
+type StreamsKafkaNetworking struct {
+	Access *StreamsKafkaNetworkingAccess `json:",omitempty"`
+}
+
+// StreamsAWSConnectionConfig is autogenerated from the json schema
+type StreamsAWSConnectionConfig struct {
+	RoleArn *string `json:",omitempty"`
+}
+
+// StreamsKafkaNetworkingAccess is autogenerated from the json schema
+type StreamsKafkaNetworkingAccess struct {
+	Type         *string `json:",omitempty"`
+	ConnectionId *string `json:",omitempty"`
+}
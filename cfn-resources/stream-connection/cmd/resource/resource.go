@@ -19,9 +19,10 @@ import (
 	"fmt"
 	"net/http"
 
-	admin20231115014 "go.mongodb.org/atlas-sdk/v20231115014/admin"
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
@@ -30,8 +31,13 @@ import (
 )
 
 const (
-	ClusterConnectionType = "Cluster"
-	KafkaConnectionType   = "Kafka"
+	ClusterConnectionType   = "Cluster"
+	KafkaConnectionType     = "Kafka"
+	SampleConnectionType    = "Sample"
+	HTTPSConnectionType     = "Https"
+	AWSLambdaConnectionType = "AWSLambda"
+
+	PrivateLinkNetworkingType = "PRIVATE_LINK"
 )
 
 var CreateRequiredFields = []string{constants.ProjectID, constants.InstanceName, constants.ConnectionName, constants.Type}
@@ -40,7 +46,16 @@ var UpdateRequiredFields = []string{constants.ProjectID, constants.InstanceName,
 var DeleteRequiredFields = []string{constants.ProjectID, constants.InstanceName, constants.ConnectionName}
 var ListRequiredFields = []string{constants.ProjectID, constants.InstanceName}
 
-func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin20231115014.APIClient, *handler.ProgressEvent) {
+// Custom validation of the fields required by each connection Type
+var requiredPerType = map[string][]string{
+	ClusterConnectionType:   {constants.ClusterName},
+	KafkaConnectionType:     {"BootstrapServers", "Authentication", "Security"},
+	SampleConnectionType:    {},
+	HTTPSConnectionType:     {"Url"},
+	AWSLambdaConnectionType: {"Aws.RoleArn"},
+}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
 	util.SetupLogger("mongodb-atlas-stream-connection")
 
 	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
@@ -53,7 +68,7 @@ func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredF
 	if peErr != nil {
 		return nil, peErr
 	}
-	return client.Atlas20231115014, nil
+	return client.AtlasSDK, nil
 }
 
 func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
@@ -62,6 +77,10 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *peErr, nil
 	}
 
+	if errEvent := ValidateTypeFields(currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
 	ctx := context.Background()
 
 	projectID := currentModel.ProjectId
@@ -110,6 +129,10 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 		return *peErr, nil
 	}
 
+	if errEvent := ValidateTypeFields(currentModel); errEvent != nil {
+		return *errEvent, nil
+	}
+
 	ctx := context.Background()
 
 	projectID := currentModel.ProjectId
@@ -141,7 +164,7 @@ func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler
 	projectID := currentModel.ProjectId
 	instanceName := currentModel.InstanceName
 	connectionName := currentModel.ConnectionName
-	if _, apiResp, err := conn.StreamsApi.DeleteStreamConnection(ctx, *projectID, *instanceName, *connectionName).Execute(); err != nil {
+	if apiResp, err := conn.StreamsApi.DeleteStreamConnection(ctx, *projectID, *instanceName, *connectionName).Execute(); err != nil {
 		return handleError(apiResp, constants.DELETE, err)
 	}
 
@@ -183,12 +206,12 @@ func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 	}, nil
 }
 
-func getAllStreamConnections(ctx context.Context, conn *admin20231115014.APIClient, projectID, instanceName string) ([]admin20231115014.StreamsConnection, *http.Response, error) {
+func getAllStreamConnections(ctx context.Context, conn *admin.APIClient, projectID, instanceName string) ([]admin.StreamsConnection, *http.Response, error) {
 	pageNum := 1
-	accumulatedStreamConns := make([]admin20231115014.StreamsConnection, 0)
+	accumulatedStreamConns := make([]admin.StreamsConnection, 0)
 
 	for allRecordsRetrieved := false; !allRecordsRetrieved; {
-		streamConns, apiResp, err := conn.StreamsApi.ListStreamConnectionsWithParams(ctx, &admin20231115014.ListStreamConnectionsApiParams{
+		streamConns, apiResp, err := conn.StreamsApi.ListStreamConnectionsWithParams(ctx, &admin.ListStreamConnectionsApiParams{
 			GroupId:      projectID,
 			TenantName:   instanceName,
 			ItemsPerPage: util.Pointer(constants.DefaultListItemsPerPage),
@@ -206,6 +229,24 @@ func getAllStreamConnections(ctx context.Context, conn *admin20231115014.APIClie
 	return accumulatedStreamConns, nil, nil
 }
 
+// ValidateTypeFields checks the fields required by the connection Type, including the private link connection of Kafka connections.
+func ValidateTypeFields(model *Model) *handler.ProgressEvent {
+	connectionType := util.SafeString(model.Type)
+	requiredFields, ok := requiredPerType[connectionType]
+	if !ok {
+		return util.Pointer(progress_events.GetFailedEventByCode(fmt.Sprintf("unsupported Type %s", connectionType),
+			string(types.HandlerErrorCodeInvalidRequest)))
+	}
+	if errEvent := validator.ValidateModel(requiredFields, model); errEvent != nil {
+		return errEvent
+	}
+
+	if connectionType == KafkaConnectionType && isPrivateLinkNetworking(model.Networking) {
+		return validator.ValidateModel([]string{"Networking.Access.ConnectionId"}, model)
+	}
+	return nil
+}
+
 func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
 	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
 
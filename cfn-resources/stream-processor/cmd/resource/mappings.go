@@ -0,0 +1,150 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+const (
+	CreatedState = "CREATED"
+	StartedState = "STARTED"
+	StoppedState = "STOPPED"
+	FailedState  = "FAILED"
+)
+
+// ParsePipeline converts the stringified Pipeline of the model into the list of stages expected by the Atlas Admin API.
+func ParsePipeline(pipeline *string) ([]any, error) {
+	stages := make([]any, 0)
+	if !util.IsStringPresent(pipeline) {
+		return stages, nil
+	}
+	if err := json.Unmarshal([]byte(*pipeline), &stages); err != nil {
+		return nil, fmt.Errorf("invalid Pipeline, must be a json array of stages: %w", err)
+	}
+	return stages, nil
+}
+
+func NewStreamProcessorReq(model *Model, pipeline []any) *admin.StreamsProcessor {
+	if model == nil {
+		return nil
+	}
+	streamProcessorReq := &admin.StreamsProcessor{
+		Name:     model.ProcessorName,
+		Pipeline: &pipeline,
+	}
+	if dlq := newStreamsDLQ(model.Options); dlq != nil {
+		streamProcessorReq.Options = &admin.StreamsOptions{Dlq: dlq}
+	}
+	return streamProcessorReq
+}
+
+func NewModifyStreamProcessorReq(model *Model, pipeline []any) *admin.StreamsModifyStreamProcessor {
+	if model == nil {
+		return nil
+	}
+	modifyReq := &admin.StreamsModifyStreamProcessor{
+		Name:     model.ProcessorName,
+		Pipeline: &pipeline,
+	}
+	if dlq := newStreamsDLQ(model.Options); dlq != nil {
+		modifyReq.Options = &admin.StreamsModifyStreamProcessorOptions{Dlq: dlq}
+	}
+	return modifyReq
+}
+
+func newStreamsDLQ(options *StreamsOptions) *admin.StreamsDLQ {
+	if options == nil || options.Dlq == nil {
+		return nil
+	}
+	return &admin.StreamsDLQ{
+		ConnectionName: options.Dlq.ConnectionName,
+		Db:             options.Dlq.Db,
+		Coll:           options.Dlq.Coll,
+	}
+}
+
+func GetStreamProcessorModel(streamProcessor *admin.StreamsProcessorWithStats, currentModel *Model) *Model {
+	model := new(Model)
+
+	if currentModel != nil {
+		model = currentModel
+	}
+	if streamProcessor == nil {
+		return model
+	}
+
+	model.Id = util.StringPtr(streamProcessor.Id)
+	model.ProcessorName = util.StringPtr(streamProcessor.Name)
+	model.State = util.StringPtr(streamProcessor.State)
+	model.Pipeline = pipelineToString(streamProcessor.Pipeline, model.Pipeline)
+	model.Stats = statsToString(streamProcessor.Stats)
+	model.Options = nil
+	if streamProcessor.Options != nil && streamProcessor.Options.Dlq != nil {
+		dlq := streamProcessor.Options.Dlq
+		model.Options = &StreamsOptions{
+			Dlq: &StreamsDLQ{
+				ConnectionName: dlq.ConnectionName,
+				Db:             dlq.Db,
+				Coll:           dlq.Coll,
+			},
+		}
+	}
+	return model
+}
+
+// pipelineToString keeps the pipeline of the template when it is equivalent to the one returned by Atlas,
+// so formatting or key ordering differences aren't reported as drift.
+func pipelineToString(pipeline []any, currentPipeline *string) *string {
+	if currentStages, err := ParsePipeline(currentPipeline); err == nil && reflect.DeepEqual(currentStages, normalizeStages(pipeline)) {
+		return currentPipeline
+	}
+	pipelineJSON, err := json.Marshal(pipeline)
+	if err != nil {
+		return currentPipeline
+	}
+	return util.Pointer(string(pipelineJSON))
+}
+
+// normalizeStages round-trips the stages through json so they can be compared with the ones parsed from the template.
+func normalizeStages(pipeline []any) []any {
+	stages := make([]any, 0)
+	if len(pipeline) == 0 {
+		return stages
+	}
+	pipelineJSON, err := json.Marshal(pipeline)
+	if err != nil {
+		return stages
+	}
+	_ = json.Unmarshal(pipelineJSON, &stages)
+	return stages
+}
+
+func statsToString(stats any) *string {
+	if stats == nil {
+		return nil
+	}
+	statsJSON, err := json.Marshal(stats)
+	if err != nil {
+		return nil
+	}
+	return util.Pointer(string(statsJSON))
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/stream-processor/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	processorID   = "65d6c4f9e3e5f0356a1b2c3d"
+	processorName = "processor"
+	pipeline      = `[{"$source": {"connectionName": "sample_stream_solar"}}, {"$emit": {"connectionName": "__testLog"}}]`
+)
+
+var pipelineStages = []any{
+	map[string]any{"$source": map[string]any{"connectionName": "sample_stream_solar"}},
+	map[string]any{"$emit": map[string]any{"connectionName": "__testLog"}},
+}
+
+func TestParsePipeline(t *testing.T) {
+	tests := []struct {
+		input    *string
+		name     string
+		expected []any
+		wantErr  bool
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: []any{},
+		},
+		{
+			name:     "Valid Pipeline",
+			input:    ptr.String(pipeline),
+			expected: pipelineStages,
+		},
+		{
+			name:    "Pipeline is not an array",
+			input:   ptr.String(`{"$source": {}}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := resource.ParsePipeline(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNewStreamProcessorReq(t *testing.T) {
+	tests := []struct {
+		input    *resource.Model
+		expected *admin.StreamsProcessor
+		name     string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "Without Options",
+			input: &resource.Model{
+				ProcessorName: ptr.String(processorName),
+				Pipeline:      ptr.String(pipeline),
+			},
+			expected: &admin.StreamsProcessor{
+				Name:     ptr.String(processorName),
+				Pipeline: &pipelineStages,
+			},
+		},
+		{
+			name: "With DLQ",
+			input: &resource.Model{
+				ProcessorName: ptr.String(processorName),
+				Pipeline:      ptr.String(pipeline),
+				Options: &resource.StreamsOptions{
+					Dlq: &resource.StreamsDLQ{
+						ConnectionName: ptr.String("cluster"),
+						Db:             ptr.String("dlq"),
+						Coll:           ptr.String("messages"),
+					},
+				},
+			},
+			expected: &admin.StreamsProcessor{
+				Name:     ptr.String(processorName),
+				Pipeline: &pipelineStages,
+				Options: &admin.StreamsOptions{
+					Dlq: &admin.StreamsDLQ{
+						ConnectionName: ptr.String("cluster"),
+						Db:             ptr.String("dlq"),
+						Coll:           ptr.String("messages"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.NewStreamProcessorReq(tt.input, pipelineStages)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetStreamProcessorModel(t *testing.T) {
+	tests := []struct {
+		input        *admin.StreamsProcessorWithStats
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			input:    nil,
+			expected: new(resource.Model),
+		},
+		{
+			name: "Equivalent pipeline keeps the template value",
+			input: &admin.StreamsProcessorWithStats{
+				Id:       processorID,
+				Name:     processorName,
+				Pipeline: pipelineStages,
+				State:    resource.StartedState,
+				Stats:    map[string]any{"inputMessageCount": 10},
+			},
+			currentModel: &resource.Model{
+				Pipeline: ptr.String(pipeline),
+			},
+			expected: &resource.Model{
+				Id:            ptr.String(processorID),
+				ProcessorName: ptr.String(processorName),
+				Pipeline:      ptr.String(pipeline),
+				State:         ptr.String(resource.StartedState),
+				Stats:         ptr.String(`{"inputMessageCount":10}`),
+			},
+		},
+		{
+			name: "Pipeline and DLQ from Atlas",
+			input: &admin.StreamsProcessorWithStats{
+				Id:       processorID,
+				Name:     processorName,
+				Pipeline: []any{map[string]any{"$source": map[string]any{"connectionName": "kafka"}}},
+				State:    resource.CreatedState,
+				Options: &admin.StreamsOptions{
+					Dlq: &admin.StreamsDLQ{
+						ConnectionName: ptr.String("cluster"),
+						Db:             ptr.String("dlq"),
+						Coll:           ptr.String("messages"),
+					},
+				},
+			},
+			currentModel: &resource.Model{
+				Pipeline: ptr.String(pipeline),
+			},
+			expected: &resource.Model{
+				Id:            ptr.String(processorID),
+				ProcessorName: ptr.String(processorName),
+				Pipeline:      ptr.String(`[{"$source":{"connectionName":"kafka"}}]`),
+				State:         ptr.String(resource.CreatedState),
+				Options: &resource.StreamsOptions{
+					Dlq: &resource.StreamsDLQ{
+						ConnectionName: ptr.String("cluster"),
+						Db:             ptr.String("dlq"),
+						Coll:           ptr.String("messages"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := resource.GetStreamProcessorModel(tt.input, tt.currentModel)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
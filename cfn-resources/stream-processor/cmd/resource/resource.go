@@ -0,0 +1,316 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+const (
+	ProcessorName = "ProcessorName"
+
+	callBackSeconds     = 10
+	callbackTargetState = "callbackStreamProcessorState"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.InstanceName, ProcessorName, "Pipeline"}
+var ReadRequiredFields = []string{constants.ProjectID, constants.InstanceName, ProcessorName}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.InstanceName, ProcessorName, "Pipeline"}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.InstanceName, ProcessorName}
+var ListRequiredFields = []string{constants.ProjectID, constants.InstanceName}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-stream-processor")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if targetState, isCallback := req.CallbackContext[callbackTargetState]; isCallback {
+		return validateProgress(conn, currentModel, targetState.(string), constants.CREATE)
+	}
+
+	desiredState := util.SafeString(currentModel.State)
+	if desiredState == StoppedState {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("State %s is not supported when creating a stream processor, use %s or %s", StoppedState, CreatedState, StartedState),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	pipeline, err := ParsePipeline(currentModel.Pipeline)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	ctx := context.Background()
+
+	projectID := currentModel.ProjectId
+	instanceName := currentModel.InstanceName
+	processorName := currentModel.ProcessorName
+	if _, apiResp, err := conn.StreamsApi.CreateStreamProcessor(ctx, *projectID, *instanceName, NewStreamProcessorReq(currentModel, pipeline)).Execute(); err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	if desiredState == StartedState {
+		if apiResp, err := conn.StreamsApi.StartStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute(); err != nil {
+			// Remove the processor so that it isn't left behind untracked and a retry doesn't fail with AlreadyExists
+			if _, deleteErr := conn.StreamsApi.DeleteStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute(); deleteErr != nil {
+				_, _ = logger.Warnf("Create - unable to delete stream processor %s after start failure: %s", *processorName, deleteErr.Error())
+			}
+			return handleError(apiResp, constants.CREATE, err)
+		}
+		return inProgressEvent(currentModel, StartedState), nil
+	}
+
+	return readStreamProcessor(conn, currentModel, constants.CREATE, "Create Completed")
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	return readStreamProcessor(conn, currentModel, constants.READ, "")
+}
+
+// Update modifies the pipeline and options of the stream processor and then moves it to the desired State.
+// Atlas only allows modifying stopped stream processors, so a running processor is stopped first and started
+// again afterwards when State is STARTED.
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if targetState, isCallback := req.CallbackContext[callbackTargetState]; isCallback {
+		return validateProgress(conn, currentModel, targetState.(string), constants.UPDATE)
+	}
+
+	pipeline, err := ParsePipeline(currentModel.Pipeline)
+	if err != nil {
+		return progress_events.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	ctx := context.Background()
+
+	projectID := currentModel.ProjectId
+	instanceName := currentModel.InstanceName
+	processorName := currentModel.ProcessorName
+	streamProcessor, apiResp, err := conn.StreamsApi.GetStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	currentState := streamProcessor.State
+	desiredState := util.SafeString(currentModel.State)
+	if desiredState == "" {
+		desiredState = currentState
+	}
+	if desiredState == CreatedState && currentState != CreatedState {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("stream processor %s can't be moved back to %s, use %s instead", *processorName, CreatedState, StoppedState),
+			string(types.HandlerErrorCodeInvalidRequest)), nil
+	}
+
+	if isModified(prevModel, currentModel) {
+		if currentState == StartedState {
+			if apiResp, err := conn.StreamsApi.StopStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute(); err != nil {
+				return handleError(apiResp, constants.UPDATE, err)
+			}
+			currentState = StoppedState
+		}
+		if _, apiResp, err := conn.StreamsApi.UpdateStreamProcessor(ctx, *projectID, *instanceName, *processorName, NewModifyStreamProcessorReq(currentModel, pipeline)).Execute(); err != nil {
+			return handleError(apiResp, constants.UPDATE, err)
+		}
+	}
+
+	switch {
+	case desiredState == StartedState && currentState != StartedState:
+		if apiResp, err := conn.StreamsApi.StartStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute(); err != nil {
+			return handleError(apiResp, constants.UPDATE, err)
+		}
+		return inProgressEvent(currentModel, StartedState), nil
+	case desiredState == StoppedState && currentState == StartedState:
+		if apiResp, err := conn.StreamsApi.StopStreamProcessor(ctx, *projectID, *instanceName, *processorName).Execute(); err != nil {
+			return handleError(apiResp, constants.UPDATE, err)
+		}
+		return inProgressEvent(currentModel, StoppedState), nil
+	}
+
+	return readStreamProcessor(conn, currentModel, constants.UPDATE, "Update Completed")
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	projectID := currentModel.ProjectId
+	instanceName := currentModel.InstanceName
+	processorName := currentModel.ProcessorName
+	if apiResp, err := conn.StreamsApi.DeleteStreamProcessor(context.Background(), *projectID, *instanceName, *processorName).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	streamProcessors, apiResp, err := getAllStreamProcessors(context.Background(), conn, *currentModel.ProjectId, *currentModel.InstanceName)
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	response := make([]interface{}, 0)
+	for i := range streamProcessors {
+		model := GetStreamProcessorModel(&streamProcessors[i], nil)
+		model.ProjectId = currentModel.ProjectId
+		model.InstanceName = currentModel.InstanceName
+		model.Profile = currentModel.Profile
+
+		response = append(response, model)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  response,
+	}, nil
+}
+
+func readStreamProcessor(conn *admin.APIClient, currentModel *Model, method constants.CfnFunctions, message string) (handler.ProgressEvent, error) {
+	projectID := currentModel.ProjectId
+	instanceName := currentModel.InstanceName
+	processorName := currentModel.ProcessorName
+	streamProcessor, apiResp, err := conn.StreamsApi.GetStreamProcessor(context.Background(), *projectID, *instanceName, *processorName).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	resourceModel := GetStreamProcessorModel(streamProcessor, currentModel)
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         message,
+		ResourceModel:   resourceModel,
+	}, nil
+}
+
+func inProgressEvent(model *Model, targetState string) handler.ProgressEvent {
+	return handler.ProgressEvent{
+		OperationStatus:      handler.InProgress,
+		Message:              constants.Pending,
+		ResourceModel:        model,
+		CallbackDelaySeconds: callBackSeconds,
+		CallbackContext:      map[string]any{callbackTargetState: targetState},
+	}
+}
+
+func validateProgress(conn *admin.APIClient, currentModel *Model, targetState string, method constants.CfnFunctions) (handler.ProgressEvent, error) {
+	streamProcessor, apiResp, err := conn.StreamsApi.GetStreamProcessor(context.Background(), *currentModel.ProjectId, *currentModel.InstanceName, *currentModel.ProcessorName).Execute()
+	if err != nil {
+		return handleError(apiResp, method, err)
+	}
+
+	switch streamProcessor.State {
+	case targetState:
+		return handler.ProgressEvent{
+			OperationStatus: handler.Success,
+			Message:         constants.Complete,
+			ResourceModel:   GetStreamProcessorModel(streamProcessor, currentModel),
+		}, nil
+	case FailedState:
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("stream processor %s failed while transitioning to %s", *currentModel.ProcessorName, targetState),
+			string(types.HandlerErrorCodeGeneralServiceException)), nil
+	default:
+		return inProgressEvent(currentModel, targetState), nil
+	}
+}
+
+func isModified(prevModel, currentModel *Model) bool {
+	if prevModel == nil {
+		return true
+	}
+	prevPipeline, err := ParsePipeline(prevModel.Pipeline)
+	if err != nil {
+		return true
+	}
+	currentPipeline, _ := ParsePipeline(currentModel.Pipeline)
+	return !reflect.DeepEqual(prevPipeline, currentPipeline) || !reflect.DeepEqual(prevModel.Options, currentModel.Options)
+}
+
+func getAllStreamProcessors(ctx context.Context, conn *admin.APIClient, projectID, instanceName string) ([]admin.StreamsProcessorWithStats, *http.Response, error) {
+	pageNum := 1
+	accumulatedStreamProcessors := make([]admin.StreamsProcessorWithStats, 0)
+
+	for allRecordsRetrieved := false; !allRecordsRetrieved; {
+		streamProcessors, apiResp, err := conn.StreamsApi.GetStreamProcessorsWithParams(ctx, &admin.GetStreamProcessorsApiParams{
+			GroupId:      projectID,
+			TenantName:   instanceName,
+			ItemsPerPage: util.Pointer(constants.DefaultListItemsPerPage),
+			PageNum:      util.Pointer(pageNum),
+		}).Execute()
+
+		if err != nil {
+			return nil, apiResp, err
+		}
+		accumulatedStreamProcessors = append(accumulatedStreamProcessors, streamProcessors.GetResults()...)
+		allRecordsRetrieved = streamProcessors.GetTotalCount() <= len(accumulatedStreamProcessors)
+		pageNum++
+	}
+
+	return accumulatedStreamProcessors, nil, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
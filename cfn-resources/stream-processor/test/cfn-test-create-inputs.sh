@@ -0,0 +1,52 @@
+#!/usr/bin/env bash
+# cfn-test-create-inputs.sh
+#
+# This tool generates json files in the inputs/ for `cfn test`.
+#
+
+set -euo pipefail
+
+rm -rf inputs
+mkdir inputs
+
+#set profile
+profile="default"
+if [ ${MONGODB_ATLAS_PROFILE+x} ]; then
+	echo "profile set to ${MONGODB_ATLAS_PROFILE}"
+	profile=${MONGODB_ATLAS_PROFILE}
+fi
+
+projectName="${1:-$PROJECT_NAME}"
+echo "$projectName"
+projectId=$(atlas projects list --output json | jq --arg NAME "${projectName}" -r '.results[] | select(.name==$NAME) | .id')
+if [ -z "$projectId" ]; then
+	projectId=$(atlas projects create "${projectName}" --output=json | jq -r '.id')
+
+	echo -e "Created project \"${projectName}\" with id: ${projectId}\n"
+else
+	echo -e "FOUND project \"${projectName}\" with id: ${projectId}\n"
+fi
+echo -e "=====\nrun this command to clean up\n=====\nmongocli iam projects delete ${projectId} --force\n====="
+
+instanceName="stream-instance-$(date +%s)-$RANDOM"
+
+atlas streams instances create "${instanceName}" --projectId "${projectId}" --region VIRGINIA_USA --provider AWS
+echo -e "Created StreamInstance \"${instanceName}\""
+
+atlas streams connections create sample_stream_solar --projectId "${projectId}" --instance "${instanceName}" --file <(echo '{"name": "sample_stream_solar", "type": "Sample"}')
+echo -e "Created sample StreamConnection"
+
+cd "$(dirname "$0")" || exit
+for inputFile in inputs_*; do
+	jq --arg instance_name "$instanceName" \
+		--arg project_id "$projectId" \
+		--arg profile "$profile" \
+		'.Profile?|=$profile
+   | .ProjectId?|=$project_id
+   | .InstanceName?|=$instance_name' \
+		"$inputFile" >"../inputs/$inputFile"
+done
+
+cd ..
+
+ls -l inputs
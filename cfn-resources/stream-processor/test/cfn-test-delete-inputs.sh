@@ -0,0 +1,28 @@
+#!/usr/bin/env bash
+# cfn-test-delete-inputs.sh
+#
+# This tool deletes the mongodb resources used for `cfn test` as inputs.
+#
+
+set -euo pipefail
+
+function usage {
+	echo "usage:$0 "
+}
+
+projectId=$(jq -r '.ProjectId' ./inputs/inputs_1_create.json)
+instanceName=$(jq -r '.InstanceName' ./inputs/inputs_1_create.json)
+
+#delete stream instance
+if atlas streams instances delete "${instanceName}" --projectId "${projectId}" --force; then
+	echo "deleting stream instance with name ${instanceName}"
+else
+	echo "failed to delete the stream instance with name ${instanceName}"
+fi
+
+#delete project
+if atlas projects delete "$projectId" --force; then
+	echo "$projectId project deletion OK"
+else
+	(echo "Failed cleaning project:$projectId" && exit 1)
+fi
@@ -0,0 +1,41 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+func NewTeamRoleReq(model *Model) *admin.TeamRole {
+	return &admin.TeamRole{
+		TeamId:    util.SafeString(model.TeamId),
+		RoleNames: model.RoleNames,
+	}
+}
+
+func GetTeamProjectAssignmentModel(teamRole *admin.TeamRole, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+	}
+	if teamRole != nil {
+		model.TeamId = util.StringPtr(teamRole.TeamId)
+		model.RoleNames = teamRole.RoleNames
+	}
+	return model
+}
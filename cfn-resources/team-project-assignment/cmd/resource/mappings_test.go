@@ -0,0 +1,69 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/team-project-assignment/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTeamRoleReq(t *testing.T) {
+	model := &resource.Model{
+		ProjectId: ptr.String("111111111111111111111111"),
+		TeamId:    ptr.String("222222222222222222222222"),
+		RoleNames: []string{"GROUP_READ_ONLY"},
+	}
+	expected := &admin.TeamRole{TeamId: "222222222222222222222222", RoleNames: []string{"GROUP_READ_ONLY"}}
+	assert.Equal(t, expected, resource.NewTeamRoleReq(model))
+}
+
+func TestGetTeamProjectAssignmentModel(t *testing.T) {
+	tests := []struct {
+		teamRole     *admin.TeamRole
+		currentModel *resource.Model
+		expected     *resource.Model
+		name         string
+	}{
+		{
+			name:     "Nil Input",
+			expected: new(resource.Model),
+		},
+		{
+			name:     "Team Role",
+			teamRole: &admin.TeamRole{TeamId: "222222222222222222222222", RoleNames: []string{"GROUP_OWNER", "GROUP_READ_ONLY"}},
+			currentModel: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+				RoleNames: []string{"GROUP_OWNER"},
+			},
+			expected: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+				TeamId:    ptr.String("222222222222222222222222"),
+				RoleNames: []string{"GROUP_OWNER", "GROUP_READ_ONLY"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetTeamProjectAssignmentModel(tc.teamRole, tc.currentModel))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
+	progress_events "github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
+)
+
+var CreateRequiredFields = []string{constants.ProjectID, constants.TeamID, constants.RoleNames}
+var ReadRequiredFields = []string{constants.ProjectID, constants.TeamID}
+var UpdateRequiredFields = []string{constants.ProjectID, constants.TeamID, constants.RoleNames}
+var DeleteRequiredFields = []string{constants.ProjectID, constants.TeamID}
+var ListRequiredFields = []string{constants.ProjectID}
+
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
+	util.SetupLogger("mongodb-atlas-team-project-assignment")
+
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
+
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
+	}
+
+	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	if peErr != nil {
+		return nil, peErr
+	}
+	return client.AtlasSDK, nil
+}
+
+// Create fails if the team is already assigned to the project: adding it again would silently change the roles
+// of an assignment managed somewhere else.
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, CreateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	teamID := *currentModel.TeamId
+	if _, _, err := conn.TeamsApi.GetGroupTeam(ctx, projectID, teamID).Execute(); err == nil {
+		return progress_events.GetFailedEventByCode(fmt.Sprintf("team %s is already assigned to project %s", teamID, projectID),
+			string(types.HandlerErrorCodeAlreadyExists)), nil
+	}
+
+	if _, apiResp, err := conn.TeamsApi.AddGroupTeams(ctx, projectID, &[]admin.TeamRole{*NewTeamRoleReq(currentModel)}).Execute(); err != nil {
+		return handleError(apiResp, constants.CREATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Create Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ReadRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	teamRole, apiResp, err := conn.TeamsApi.GetGroupTeam(context.Background(), *currentModel.ProjectId, *currentModel.TeamId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.READ, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetTeamProjectAssignmentModel(teamRole, currentModel),
+	}, nil
+}
+
+func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, UpdateRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	ctx := context.Background()
+	projectID := *currentModel.ProjectId
+	teamID := *currentModel.TeamId
+	if _, apiResp, err := conn.TeamsApi.GetGroupTeam(ctx, projectID, teamID).Execute(); err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	teamRole := &admin.TeamRole{RoleNames: currentModel.RoleNames}
+	if _, apiResp, err := conn.TeamsApi.UpdateGroupTeam(ctx, projectID, teamID, teamRole).Execute(); err != nil {
+		return handleError(apiResp, constants.UPDATE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Update Completed",
+		ResourceModel:   currentModel,
+	}, nil
+}
+
+func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, DeleteRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	if apiResp, err := conn.TeamsApi.RemoveGroupTeam(context.Background(), *currentModel.ProjectId, *currentModel.TeamId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
+	}, nil
+}
+
+func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+
+	teamRoles, apiResp, err := conn.TeamsApi.ListGroupTeams(context.Background(), *currentModel.ProjectId).Execute()
+	if err != nil {
+		return handleError(apiResp, constants.LIST, err)
+	}
+
+	results := teamRoles.GetResults()
+	models := make([]any, 0, len(results))
+	for i := range results {
+		models = append(models, GetTeamProjectAssignmentModel(&results[i], currentModel))
+	}
+
+	return handler.ProgressEvent{
+		OperationStatus: handler.Success,
+		ResourceModels:  models,
+	}, nil
+}
+
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progress_events.GetFailedEventByResponse(errMsg, response), nil
+}
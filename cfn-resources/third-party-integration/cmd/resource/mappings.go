@@ -0,0 +1,168 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+)
+
+const (
+	TypeDatadog        = "DATADOG"
+	TypePagerDuty      = "PAGER_DUTY"
+	TypeOpsGenie       = "OPS_GENIE"
+	TypeVictorOps      = "VICTOR_OPS"
+	TypeSlack          = "SLACK"
+	TypeWebhook        = "WEBHOOK"
+	TypeMicrosoftTeams = "MICROSOFT_TEAMS"
+	TypePrometheus     = "PROMETHEUS"
+)
+
+// configuredTypes returns the integration types whose configuration block is set in the model.
+func configuredTypes(model *Model) []string {
+	blocks := []struct {
+		integrationType string
+		set             bool
+	}{
+		{TypeDatadog, model.Datadog != nil},
+		{TypePagerDuty, model.PagerDuty != nil},
+		{TypeOpsGenie, model.OpsGenie != nil},
+		{TypeVictorOps, model.VictorOps != nil},
+		{TypeSlack, model.Slack != nil},
+		{TypeWebhook, model.Webhook != nil},
+		{TypeMicrosoftTeams, model.MicrosoftTeams != nil},
+		{TypePrometheus, model.Prometheus != nil},
+	}
+	types := make([]string, 0, 1)
+	for _, block := range blocks {
+		if block.set {
+			types = append(types, block.integrationType)
+		}
+	}
+	return types
+}
+
+// ValidateIntegration checks that exactly one configuration block is set and that it matches Type.
+func ValidateIntegration(model *Model) error {
+	integrationType := *model.Type
+	types := configuredTypes(model)
+	switch {
+	case len(types) == 0:
+		return fmt.Errorf("the configuration block of the %s integration must be set", integrationType)
+	case len(types) > 1:
+		return fmt.Errorf("only one configuration block can be set, found blocks for %s", strings.Join(types, ", "))
+	case types[0] != integrationType:
+		return fmt.Errorf("the configuration block for %s doesn't match the integration type %s", types[0], integrationType)
+	}
+	return nil
+}
+
+func NewIntegrationReq(model *Model) *admin.ThirdPartyIntegration {
+	integration := &admin.ThirdPartyIntegration{Type: model.Type}
+	switch {
+	case model.Datadog != nil:
+		integration.ApiKey = model.Datadog.ApiKey
+		integration.Region = model.Datadog.Region
+		integration.SendCollectionLatencyMetrics = model.Datadog.SendCollectionLatencyMetrics
+		integration.SendDatabaseMetrics = model.Datadog.SendDatabaseMetrics
+		integration.SendQueryStatsMetrics = model.Datadog.SendQueryStatsMetrics
+		integration.SendUserProvidedResourceTags = model.Datadog.SendUserProvidedResourceTags
+	case model.PagerDuty != nil:
+		integration.ServiceKey = model.PagerDuty.ServiceKey
+		integration.Region = model.PagerDuty.Region
+	case model.OpsGenie != nil:
+		integration.ApiKey = model.OpsGenie.ApiKey
+		integration.Region = model.OpsGenie.Region
+	case model.VictorOps != nil:
+		integration.ApiKey = model.VictorOps.ApiKey
+		integration.RoutingKey = model.VictorOps.RoutingKey
+	case model.Slack != nil:
+		integration.ApiToken = model.Slack.ApiToken
+		integration.ChannelName = model.Slack.ChannelName
+		integration.TeamName = model.Slack.TeamName
+	case model.Webhook != nil:
+		integration.Url = model.Webhook.Url
+		integration.Secret = model.Webhook.Secret
+	case model.MicrosoftTeams != nil:
+		integration.MicrosoftTeamsWebhookUrl = model.MicrosoftTeams.MicrosoftTeamsWebhookUrl
+	case model.Prometheus != nil:
+		integration.Username = model.Prometheus.Username
+		integration.Password = model.Prometheus.Password
+		integration.ServiceDiscovery = model.Prometheus.ServiceDiscovery
+		integration.Enabled = model.Prometheus.Enabled
+		integration.SendUserProvidedResourceTagsEnabled = model.Prometheus.SendUserProvidedResourceTagsEnabled
+	}
+	return integration
+}
+
+// GetIntegrationModel only sets the non-secret fields of the configuration block. Atlas returns keys, tokens
+// and webhook URLs redacted, so they are write-only and never read back.
+func GetIntegrationModel(integration *admin.ThirdPartyIntegration, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.ProjectId = currentModel.ProjectId
+		model.Profile = currentModel.Profile
+	}
+	if integration == nil {
+		return model
+	}
+	model.Type = integration.Type
+	switch integration.GetType() {
+	case TypeDatadog:
+		model.Datadog = &Datadog{
+			Region:                       integration.Region,
+			SendCollectionLatencyMetrics: integration.SendCollectionLatencyMetrics,
+			SendDatabaseMetrics:          integration.SendDatabaseMetrics,
+			SendQueryStatsMetrics:        integration.SendQueryStatsMetrics,
+			SendUserProvidedResourceTags: integration.SendUserProvidedResourceTags,
+		}
+	case TypePagerDuty:
+		model.PagerDuty = &PagerDuty{Region: integration.Region}
+	case TypeOpsGenie:
+		model.OpsGenie = &OpsGenie{Region: integration.Region}
+	case TypeVictorOps:
+		model.VictorOps = &VictorOps{RoutingKey: integration.RoutingKey}
+	case TypeSlack:
+		model.Slack = &Slack{ChannelName: integration.ChannelName, TeamName: integration.TeamName}
+	case TypeWebhook:
+		model.Webhook = &Webhook{}
+	case TypeMicrosoftTeams:
+		model.MicrosoftTeams = &MicrosoftTeams{}
+	case TypePrometheus:
+		model.Prometheus = &Prometheus{
+			Username:                            integration.Username,
+			ServiceDiscovery:                    integration.ServiceDiscovery,
+			Enabled:                             integration.Enabled,
+			SendUserProvidedResourceTagsEnabled: integration.SendUserProvidedResourceTagsEnabled,
+		}
+	}
+	return model
+}
+
+// FindIntegration returns the integration of the given type from the results of a create or update request.
+func FindIntegration(integrations *admin.PaginatedIntegration, integrationType string) *admin.ThirdPartyIntegration {
+	if integrations == nil {
+		return nil
+	}
+	results := integrations.GetResults()
+	for i := range results {
+		if results[i].GetType() == integrationType {
+			return &results[i]
+		}
+	}
+	return nil
+}
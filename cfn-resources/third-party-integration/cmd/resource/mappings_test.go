@@ -0,0 +1,214 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/third-party-integration/cmd/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIntegration(t *testing.T) {
+	tests := []struct {
+		model       *resource.Model
+		name        string
+		expectedErr string
+	}{
+		{
+			name:  "Matching Block",
+			model: &resource.Model{Type: ptr.String(resource.TypeWebhook), Webhook: &resource.Webhook{Url: ptr.String("https://example.com")}},
+		},
+		{
+			name:        "Missing Block",
+			model:       &resource.Model{Type: ptr.String(resource.TypeDatadog)},
+			expectedErr: "the configuration block of the DATADOG integration must be set",
+		},
+		{
+			name: "Multiple Blocks",
+			model: &resource.Model{
+				Type:      ptr.String(resource.TypeDatadog),
+				Datadog:   &resource.Datadog{ApiKey: ptr.String("key"), Region: ptr.String("US")},
+				PagerDuty: &resource.PagerDuty{ServiceKey: ptr.String("key")},
+			},
+			expectedErr: "only one configuration block can be set, found blocks for DATADOG, PAGER_DUTY",
+		},
+		{
+			name:        "Block Not Matching Type",
+			model:       &resource.Model{Type: ptr.String(resource.TypeSlack), OpsGenie: &resource.OpsGenie{ApiKey: ptr.String("key")}},
+			expectedErr: "the configuration block for OPS_GENIE doesn't match the integration type SLACK",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resource.ValidateIntegration(tc.model)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNewIntegrationReq(t *testing.T) {
+	tests := []struct {
+		model    *resource.Model
+		expected *admin.ThirdPartyIntegration
+		name     string
+	}{
+		{
+			name: "Datadog",
+			model: &resource.Model{
+				Type: ptr.String(resource.TypeDatadog),
+				Datadog: &resource.Datadog{
+					ApiKey:                       ptr.String("key"),
+					Region:                       ptr.String("EU"),
+					SendCollectionLatencyMetrics: ptr.Bool(true),
+					SendDatabaseMetrics:          ptr.Bool(false),
+				},
+			},
+			expected: &admin.ThirdPartyIntegration{
+				Type:                         ptr.String(resource.TypeDatadog),
+				ApiKey:                       ptr.String("key"),
+				Region:                       ptr.String("EU"),
+				SendCollectionLatencyMetrics: ptr.Bool(true),
+				SendDatabaseMetrics:          ptr.Bool(false),
+			},
+		},
+		{
+			name: "VictorOps",
+			model: &resource.Model{
+				Type:      ptr.String(resource.TypeVictorOps),
+				VictorOps: &resource.VictorOps{ApiKey: ptr.String("key"), RoutingKey: ptr.String("routing")},
+			},
+			expected: &admin.ThirdPartyIntegration{
+				Type:       ptr.String(resource.TypeVictorOps),
+				ApiKey:     ptr.String("key"),
+				RoutingKey: ptr.String("routing"),
+			},
+		},
+		{
+			name: "Prometheus",
+			model: &resource.Model{
+				Type: ptr.String(resource.TypePrometheus),
+				Prometheus: &resource.Prometheus{
+					Username:         ptr.String("user"),
+					Password:         ptr.String("password"),
+					ServiceDiscovery: ptr.String("http"),
+					Enabled:          ptr.Bool(true),
+				},
+			},
+			expected: &admin.ThirdPartyIntegration{
+				Type:             ptr.String(resource.TypePrometheus),
+				Username:         ptr.String("user"),
+				Password:         ptr.String("password"),
+				ServiceDiscovery: ptr.String("http"),
+				Enabled:          ptr.Bool(true),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.NewIntegrationReq(tc.model))
+		})
+	}
+}
+
+func TestGetIntegrationModel(t *testing.T) {
+	currentModel := &resource.Model{
+		Profile:   ptr.String("default"),
+		ProjectId: ptr.String("111111111111111111111111"),
+	}
+	tests := []struct {
+		integration *admin.ThirdPartyIntegration
+		expected    *resource.Model
+		name        string
+	}{
+		{
+			name:     "Nil Input",
+			expected: &resource.Model{Profile: ptr.String("default"), ProjectId: ptr.String("111111111111111111111111")},
+		},
+		{
+			name: "Datadog Without Api Key",
+			integration: &admin.ThirdPartyIntegration{
+				Type:                ptr.String(resource.TypeDatadog),
+				ApiKey:              ptr.String("****************1234"),
+				Region:              ptr.String("US"),
+				SendDatabaseMetrics: ptr.Bool(true),
+			},
+			expected: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+				Type:      ptr.String(resource.TypeDatadog),
+				Datadog:   &resource.Datadog{Region: ptr.String("US"), SendDatabaseMetrics: ptr.Bool(true)},
+			},
+		},
+		{
+			name: "Webhook Without Secrets",
+			integration: &admin.ThirdPartyIntegration{
+				Type:   ptr.String(resource.TypeWebhook),
+				Url:    ptr.String("https://example.com/****"),
+				Secret: ptr.String("****"),
+			},
+			expected: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+				Type:      ptr.String(resource.TypeWebhook),
+				Webhook:   &resource.Webhook{},
+			},
+		},
+		{
+			name: "Prometheus Without Password",
+			integration: &admin.ThirdPartyIntegration{
+				Type:             ptr.String(resource.TypePrometheus),
+				Username:         ptr.String("user"),
+				Password:         ptr.String("password"),
+				ServiceDiscovery: ptr.String("file"),
+				Enabled:          ptr.Bool(true),
+			},
+			expected: &resource.Model{
+				Profile:   ptr.String("default"),
+				ProjectId: ptr.String("111111111111111111111111"),
+				Type:      ptr.String(resource.TypePrometheus),
+				Prometheus: &resource.Prometheus{
+					Username:         ptr.String("user"),
+					ServiceDiscovery: ptr.String("file"),
+					Enabled:          ptr.Bool(true),
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resource.GetIntegrationModel(tc.integration, currentModel))
+		})
+	}
+}
+
+func TestFindIntegration(t *testing.T) {
+	integrations := &admin.PaginatedIntegration{
+		Results: &[]admin.ThirdPartyIntegration{
+			{Type: ptr.String(resource.TypeSlack)},
+			{Type: ptr.String(resource.TypeWebhook)},
+		},
+	}
+	assert.Equal(t, &(*integrations.Results)[1], resource.FindIntegration(integrations, resource.TypeWebhook))
+	assert.Nil(t, resource.FindIntegration(integrations, resource.TypeDatadog))
+	assert.Nil(t, resource.FindIntegration(nil, resource.TypeDatadog))
+}
@@ -18,24 +18,70 @@ package resource
 
 // Model is autogenerated from the json schema
 type Model struct {
-	ProjectId                *string `json:",omitempty"`
-	Profile                  *string `json:",omitempty"`
-	Type                     *string `json:",omitempty"`
-	ApiKey                   *string `json:",omitempty"`
-	Region                   *string `json:",omitempty"`
-	ServiceKey               *string `json:",omitempty"`
-	ApiToken                 *string `json:",omitempty"`
-	TeamName                 *string `json:",omitempty"`
-	ChannelName              *string `json:",omitempty"`
-	RoutingKey               *string `json:",omitempty"`
-	Url                      *string `json:",omitempty"`
-	Secret                   *string `json:",omitempty"`
+	ProjectId      *string         `json:",omitempty"`
+	Profile        *string         `json:",omitempty"`
+	Type           *string         `json:",omitempty"`
+	Datadog        *Datadog        `json:",omitempty"`
+	PagerDuty      *PagerDuty      `json:",omitempty"`
+	OpsGenie       *OpsGenie       `json:",omitempty"`
+	VictorOps      *VictorOps      `json:",omitempty"`
+	Slack          *Slack          `json:",omitempty"`
+	Webhook        *Webhook        `json:",omitempty"`
+	MicrosoftTeams *MicrosoftTeams `json:",omitempty"`
+	Prometheus     *Prometheus     `json:",omitempty"`
+}
+
+// Datadog is autogenerated from the json schema
+type Datadog struct {
+	ApiKey                       *string `json:",omitempty"`
+	Region                       *string `json:",omitempty"`
+	SendCollectionLatencyMetrics *bool   `json:",omitempty"`
+	SendDatabaseMetrics          *bool   `json:",omitempty"`
+	SendQueryStatsMetrics        *bool   `json:",omitempty"`
+	SendUserProvidedResourceTags *bool   `json:",omitempty"`
+}
+
+// PagerDuty is autogenerated from the json schema
+type PagerDuty struct {
+	ServiceKey *string `json:",omitempty"`
+	Region     *string `json:",omitempty"`
+}
+
+// OpsGenie is autogenerated from the json schema
+type OpsGenie struct {
+	ApiKey *string `json:",omitempty"`
+	Region *string `json:",omitempty"`
+}
+
+// VictorOps is autogenerated from the json schema
+type VictorOps struct {
+	ApiKey     *string `json:",omitempty"`
+	RoutingKey *string `json:",omitempty"`
+}
+
+// Slack is autogenerated from the json schema
+type Slack struct {
+	ApiToken    *string `json:",omitempty"`
+	ChannelName *string `json:",omitempty"`
+	TeamName    *string `json:",omitempty"`
+}
+
+// Webhook is autogenerated from the json schema
+type Webhook struct {
+	Url    *string `json:",omitempty"`
+	Secret *string `json:",omitempty"`
+}
+
+// MicrosoftTeams is autogenerated from the json schema
+type MicrosoftTeams struct {
 	MicrosoftTeamsWebhookUrl *string `json:",omitempty"`
-	UserName                 *string `json:",omitempty"`
-	Password                 *string `json:",omitempty"`
-	ServiceDiscovery         *string `json:",omitempty"`
-	Scheme                   *string `json:",omitempty"`
-	Enabled                  *bool   `json:",omitempty"`
-	ListenAddress            *string `json:",omitempty"`
-	TlsPemPath               *string `json:",omitempty"`
+}
+
+// Prometheus is autogenerated from the json schema
+type Prometheus struct {
+	Username                            *string `json:",omitempty"`
+	Password                            *string `json:",omitempty"`
+	ServiceDiscovery                    *string `json:",omitempty"`
+	Enabled                             *bool   `json:",omitempty"`
+	SendUserProvidedResourceTagsEnabled *bool   `json:",omitempty"`
 }
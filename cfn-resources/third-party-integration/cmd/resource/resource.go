@@ -16,330 +16,155 @@ package resource
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
+	"go.mongodb.org/atlas-sdk/v20250312010/admin"
+
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/constants"
-	log "github.com/mongodb/mongodbatlas-cloudformation-resources/util/logger"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/progressevent"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util/validator"
-	admin20231115002 "go.mongodb.org/atlas-sdk/v20231115002/admin"
 )
 
 var RequiredFields = []string{constants.IntegrationType, constants.ProjectID}
 var ListRequiredFields = []string{constants.ProjectID}
 
-// Custom validation only for ThirdPartyIntegrations
-var requiredPerType = map[string][]string{
-	"PAGER_DUTY":      {"ServiceKey"},
-	"DATADOG":         {"ApiKey", "Region"},
-	"NEW_RELIC":       {"LicenseKey", "AccountId", "WriteToken", "ReadToken"},
-	"OPS_GENIE":       {"ApiKey", "Region"},
-	"VICTOR_OPS":      {"ApiKey"},
-	"FLOWDOCK":        {"FlowName", "ApiToken", "OrgName"},
-	"WEBHOOK":         {"Url"},
-	"MICROSOFT_TEAMS": {"MicrosoftTeamsWebhookUrl"},
-	"PROMETHEUS":      {"UserName", "Password", "ServiceDiscovery", "Scheme", "Enabled"},
-}
-
-func validateModel(fields []string, model *Model) *handler.ProgressEvent {
-	return validator.ValidateModel(fields, model)
-}
-
-func setup() {
+func initEnvWithLatestClient(req handler.Request, currentModel *Model, requiredFields []string) (*admin.APIClient, *handler.ProgressEvent) {
 	util.SetupLogger("mongodb-atlas-thirdpartyintegration")
-}
 
-func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
+	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 
-	_, _ = log.Warnf("Create() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
+	if errEvent := validator.ValidateModel(requiredFields, currentModel); errEvent != nil {
+		return nil, errEvent
 	}
 
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
 	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
 	if peErr != nil {
-		return *peErr, nil
+		return nil, peErr
 	}
+	return client.AtlasSDK, nil
+}
 
-	ProjectID := currentModel.ProjectId
-	IntegrationType := currentModel.Type
-
-	// checking per type fields
-	requiredInputs := requiredPerType[*IntegrationType]
-	if validationErr := validateModel(requiredInputs, currentModel); validationErr != nil {
-		return *validationErr, nil
+func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
+	if peErr != nil {
+		return *peErr, nil
+	}
+	if err := ValidateIntegration(currentModel); err != nil {
+		return progressevent.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
-	requestBody := modelToIntegration(currentModel)
-	integrations, resModel, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.CreateThirdPartyIntegration(context.Background(), *IntegrationType, *ProjectID, requestBody).Execute()
+	integrationType := *currentModel.Type
+	requestBody := NewIntegrationReq(currentModel)
+	integrations, apiResp, err := conn.ThirdPartyIntegrationsApi.CreateGroupIntegration(context.Background(), integrationType, *currentModel.ProjectId, requestBody).Execute()
 	if err != nil {
-		if apiError, ok := admin20231115002.AsError(err); ok && *apiError.Error == http.StatusConflict {
-			return progressevent.GetFailedEventByCode("INTEGRATION_ALREADY_CONFIGURED.", string(types.HandlerErrorCodeAlreadyExists)), nil
-		}
-
-		return progressevent.GetFailedEventByResponse(err.Error(), resModel), nil
+		return handleError(apiResp, constants.CREATE, err)
 	}
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   integrationToModel(*currentModel, &integrations.Results[0]),
+		Message:         "Create Completed",
+		ResourceModel:   getResponseModel(integrations, requestBody, currentModel),
 	}, nil
 }
 
 func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Read() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	ProjectID := currentModel.ProjectId
-	IntegrationType := currentModel.Type
-
-	integration, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(context.Background(), *ProjectID, *IntegrationType).Execute()
-
+	integration, apiResp, err := conn.ThirdPartyIntegrationsApi.GetGroupIntegration(context.Background(), *currentModel.ProjectId, *currentModel.Type).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return handleError(apiResp, constants.READ, err)
 	}
-	_, _ = log.Debugf("Atlas Client %v", client)
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   integrationToModel(*currentModel, integration),
+		Message:         constants.ReadComplete,
+		ResourceModel:   GetIntegrationModel(integration, currentModel),
 	}, nil
 }
 
+// Update replaces the whole configuration of the integration, so the secrets of the configuration block must be
+// set again.
 func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Update() currentModel:%+v", currentModel)
-
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
 	if peErr != nil {
 		return *peErr, nil
 	}
-
-	ProjectID := currentModel.ProjectId
-	IntegrationType := currentModel.Type
-
-	integration, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(context.Background(), *ProjectID, *IntegrationType).Execute()
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+	if err := ValidateIntegration(currentModel); err != nil {
+		return progressevent.GetFailedEventByCode(err.Error(), string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
-	updateIntegrationFromSchema(currentModel, integration)
-	integrations, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.UpdateThirdPartyIntegration(context.Background(), *IntegrationType, *ProjectID, integration).Execute()
+	integrationType := *currentModel.Type
+	requestBody := NewIntegrationReq(currentModel)
+	integrations, apiResp, err := conn.ThirdPartyIntegrationsApi.UpdateGroupIntegration(context.Background(), integrationType, *currentModel.ProjectId, requestBody).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return handleError(apiResp, constants.UPDATE, err)
 	}
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   integrationToModel(*currentModel, &integrations.Results[0]),
+		Message:         "Update Completed",
+		ResourceModel:   getResponseModel(integrations, requestBody, currentModel),
 	}, nil
 }
 
-func updateIntegrationFromSchema(currentModel *Model, integration *admin20231115002.ThridPartyIntegration) {
-	if util.IsStringPresent(currentModel.Url) && !util.AreStringPtrEqual(currentModel.Url, integration.Url) {
-		integration.Url = currentModel.Url
-	}
-	if util.IsStringPresent(currentModel.ApiKey) && !util.AreStringPtrEqual(currentModel.ApiKey, integration.ApiKey) {
-		integration.ApiKey = currentModel.ApiKey
-	}
-	if util.IsStringPresent(currentModel.Region) && !util.AreStringPtrEqual(currentModel.Region, integration.Region) {
-		integration.Region = currentModel.Region
-	}
-	if util.IsStringPresent(currentModel.ServiceKey) && !util.AreStringPtrEqual(currentModel.ServiceKey, integration.ServiceKey) {
-		integration.ServiceKey = currentModel.ServiceKey
-	}
-	if util.IsStringPresent(currentModel.ApiToken) && !util.AreStringPtrEqual(currentModel.ApiToken, integration.ApiToken) {
-		integration.ApiToken = currentModel.ApiToken
-	}
-	if util.IsStringPresent(currentModel.TeamName) && !util.AreStringPtrEqual(currentModel.TeamName, integration.TeamName) {
-		integration.TeamName = currentModel.TeamName
-	}
-	if util.IsStringPresent(currentModel.ChannelName) && !util.AreStringPtrEqual(currentModel.ChannelName, integration.ChannelName) {
-		integration.ChannelName = currentModel.ChannelName
-	}
-	if util.IsStringPresent(currentModel.RoutingKey) && !util.AreStringPtrEqual(currentModel.RoutingKey, integration.RoutingKey) {
-		integration.RoutingKey = currentModel.RoutingKey
-	}
-	if util.IsStringPresent(currentModel.Secret) && !util.AreStringPtrEqual(currentModel.Secret, integration.Secret) {
-		integration.Secret = currentModel.Secret
-	}
-	if util.IsStringPresent(currentModel.MicrosoftTeamsWebhookUrl) && !util.AreStringPtrEqual(currentModel.MicrosoftTeamsWebhookUrl, integration.MicrosoftTeamsWebhookUrl) {
-		integration.MicrosoftTeamsWebhookUrl = currentModel.MicrosoftTeamsWebhookUrl
-	}
-	if util.IsStringPresent(currentModel.UserName) && !util.AreStringPtrEqual(currentModel.UserName, integration.Username) {
-		integration.Username = currentModel.UserName
-	}
-	if util.IsStringPresent(currentModel.Password) && !util.AreStringPtrEqual(currentModel.Password, integration.Password) {
-		integration.Password = currentModel.Password
-	}
-	if util.IsStringPresent(currentModel.ServiceDiscovery) && !util.AreStringPtrEqual(currentModel.ServiceDiscovery, integration.ServiceDiscovery) {
-		integration.ServiceDiscovery = currentModel.ServiceDiscovery
-	}
-	if util.IsStringPresent(currentModel.Scheme) && !util.AreStringPtrEqual(currentModel.Scheme, integration.Scheme) {
-		integration.Scheme = currentModel.Scheme
-	}
-	if currentModel.Enabled != nil && currentModel.Enabled != integration.Enabled {
-		integration.Enabled = currentModel.Enabled
-	}
-}
-
 func Delete(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("Delete() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(RequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	conn, peErr := initEnvWithLatestClient(req, currentModel, RequiredFields)
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	var res *http.Response
-	var err error
-
-	ProjectID := currentModel.ProjectId
-	IntegrationType := currentModel.Type
-
-	_, res, err = client.Atlas20231115002.ThirdPartyIntegrationsApi.DeleteThirdPartyIntegration(context.Background(), *IntegrationType, *ProjectID).Execute()
-
-	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+	if apiResp, err := conn.ThirdPartyIntegrationsApi.DeleteGroupIntegration(context.Background(), *currentModel.Type, *currentModel.ProjectId).Execute(); err != nil {
+		return handleError(apiResp, constants.DELETE, err)
 	}
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "Delete Complete",
+		Message:         "Delete Completed",
+		ResourceModel:   nil,
 	}, nil
 }
 
 func List(req handler.Request, prevModel *Model, currentModel *Model) (handler.ProgressEvent, error) {
-	setup()
-
-	_, _ = log.Debugf("List() currentModel:%+v", currentModel)
-	if modelValidation := validateModel(ListRequiredFields, currentModel); modelValidation != nil {
-		return *modelValidation, nil
-	}
-
-	util.SetDefaultProfileIfNotDefined(&currentModel.Profile)
-	client, peErr := util.NewAtlasClient(&req, currentModel.Profile)
+	conn, peErr := initEnvWithLatestClient(req, currentModel, ListRequiredFields)
 	if peErr != nil {
 		return *peErr, nil
 	}
 
-	var res *http.Response
-	ProjectID := currentModel.ProjectId
-	integrations, res, err := client.Atlas20231115002.ThirdPartyIntegrationsApi.ListThirdPartyIntegrations(context.Background(), *ProjectID).Execute()
+	integrations, apiResp, err := conn.ThirdPartyIntegrationsApi.ListGroupIntegrations(context.Background(), *currentModel.ProjectId).Execute()
 	if err != nil {
-		return progressevent.GetFailedEventByResponse(err.Error(), res), nil
+		return handleError(apiResp, constants.LIST, err)
 	}
 
-	mm := make([]interface{}, 0)
-	for i := range integrations.Results {
-		m := integrationToModel(*currentModel, &integrations.Results[i])
-		mm = append(mm, m)
+	results := integrations.GetResults()
+	models := make([]any, 0, len(results))
+	for i := range results {
+		models = append(models, GetIntegrationModel(&results[i], currentModel))
 	}
 
-	// Response
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		Message:         "List successful",
-		ResourceModels:  mm,
+		ResourceModels:  models,
 	}, nil
 }
 
-func modelToIntegration(currentModel *Model) (out *admin20231115002.ThridPartyIntegration) {
-	out = &admin20231115002.ThridPartyIntegration{}
-
-	if util.IsStringPresent(currentModel.Type) {
-		out.Type = currentModel.Type
-	}
-	if currentModel.Enabled != nil {
-		out.Enabled = currentModel.Enabled
-	}
-	if util.IsStringPresent(currentModel.Scheme) {
-		out.Scheme = currentModel.Scheme
-	}
-	if util.IsStringPresent(currentModel.ServiceDiscovery) {
-		out.ServiceDiscovery = currentModel.ServiceDiscovery
-	}
-	if util.IsStringPresent(currentModel.Password) {
-		out.Password = currentModel.Password
-	}
-	if util.IsStringPresent(currentModel.UserName) {
-		out.Username = currentModel.UserName
-	}
-	if util.IsStringPresent(currentModel.MicrosoftTeamsWebhookUrl) {
-		out.MicrosoftTeamsWebhookUrl = currentModel.MicrosoftTeamsWebhookUrl
-	}
-	if util.IsStringPresent(currentModel.Secret) {
-		out.Secret = currentModel.Secret
-	}
-	if util.IsStringPresent(currentModel.Url) {
-		out.Url = currentModel.Url
-	}
-	if util.IsStringPresent(currentModel.RoutingKey) {
-		out.RoutingKey = currentModel.RoutingKey
-	}
-	if util.IsStringPresent(currentModel.ChannelName) {
-		out.ChannelName = currentModel.ChannelName
+// getResponseModel falls back to the request when the response doesn't include the integration.
+func getResponseModel(integrations *admin.PaginatedIntegration, requestBody *admin.ThirdPartyIntegration, currentModel *Model) *Model {
+	integration := FindIntegration(integrations, requestBody.GetType())
+	if integration == nil {
+		integration = requestBody
 	}
-	if util.IsStringPresent(currentModel.TeamName) {
-		out.TeamName = currentModel.TeamName
-	}
-	if util.IsStringPresent(currentModel.ApiToken) {
-		out.ApiToken = currentModel.ApiToken
-	}
-	if util.IsStringPresent(currentModel.ServiceKey) {
-		out.ServiceKey = currentModel.ServiceKey
-	}
-	if util.IsStringPresent(currentModel.Region) {
-		out.Region = currentModel.Region
-	}
-	if util.IsStringPresent(currentModel.ApiKey) {
-		out.ApiKey = currentModel.ApiKey
-	}
-
-	return out
+	return GetIntegrationModel(integration, currentModel)
 }
 
-func integrationToModel(currentModel Model, integration *admin20231115002.ThridPartyIntegration) Model {
-	// if "Enabled" is not set in the inputs we dont want to return "Enabled" in outputs
-	enabled := currentModel.Enabled != nil
-
-	/*
-	   The variables from the thirdparty integration are not returned back in reposnse because most of the variables are sensitive variables.
-	*/
-	out := Model{
-		Type:      integration.Type,
-		ProjectId: currentModel.ProjectId,
-		Profile:   currentModel.Profile,
-	}
-
-	if !enabled {
-		out.Enabled = nil
-	}
-	return out
+func handleError(response *http.Response, method constants.CfnFunctions, err error) (handler.ProgressEvent, error) {
+	errMsg := fmt.Sprintf("%s error:%s", method, err.Error())
+	return progressevent.GetFailedEventByResponse(errMsg, response), nil
 }
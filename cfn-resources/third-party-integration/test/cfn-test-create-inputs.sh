@@ -36,13 +36,13 @@ webHookUpdateSecret="$WEBHOOK_UPDATE_SECRET"
 
 jq --arg project_id "$projectId" \
 	--arg web_hook_create_url "$webHookCreateUrl" \
-	'.ProjectId?|=$project_id | .Url?|=$web_hook_create_url' \
+	'.ProjectId?|=$project_id | .Webhook.Url?|=$web_hook_create_url' \
 	"$(dirname "$0")/inputs_1_create.template.json" >"inputs/inputs_1_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg web_hook_create_url "$webHookUpdateUrl" \
 	--arg web_hook_create_secret "$webHookUpdateSecret" \
-	'.ProjectId?|=$project_id | .Url?|=$web_hook_create_url | .Secret?|=$web_hook_create_secret' \
+	'.ProjectId?|=$project_id | .Webhook.Url?|=$web_hook_create_url | .Webhook.Secret?|=$web_hook_create_secret' \
 	"$(dirname "$0")/inputs_1_update.template.json" >"inputs/inputs_1_update.json"
 
 #PROMETHEUS
@@ -52,13 +52,13 @@ prometheusPassword="$PROMETHEUS_PASSWORD_NAME"
 jq --arg project_id "$projectId" \
 	--arg prometheus_usr_name "$prometheusUsrName" \
 	--arg prometheus_password "$prometheusPassword" \
-	'.ProjectId?|=$project_id | .UserName?|=$prometheus_usr_name | .Password?|=$prometheus_password' \
+	'.ProjectId?|=$project_id | .Prometheus.Username?|=$prometheus_usr_name | .Prometheus.Password?|=$prometheus_password' \
 	"$(dirname "$0")/inputs_2_create.template.json" >"inputs/inputs_2_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg prometheus_usr_name "$prometheusUsrName" \
 	--arg prometheus_password "$prometheusPassword" \
-	'.ProjectId?|=$project_id | .UserName?|=$prometheus_usr_name | .Password?|=$prometheus_password' \
+	'.ProjectId?|=$project_id | .Prometheus.Username?|=$prometheus_usr_name | .Prometheus.Password?|=$prometheus_password' \
 	"$(dirname "$0")/inputs_2_update.template.json" >"inputs/inputs_2_update.json"
 
 #PAGER_DUTY
@@ -68,12 +68,12 @@ pagerDutyUpdateServiceKey="$PAGER_DUTY_UPDATE_SERVICE_KEY"
 
 jq --arg project_id "$projectId" \
 	--arg service_key "$pagerDutyCreateServiceKey" \
-	'.ProjectId?|=$project_id | .ServiceKey?|=$service_key' \
+	'.ProjectId?|=$project_id | .PagerDuty.ServiceKey?|=$service_key' \
 	"$(dirname "$0")/inputs_3_create.template.json" >"inputs/inputs_3_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg service_key "$pagerDutyUpdateServiceKey" \
-	'.ProjectId?|=$project_id | .ServiceKey?|=$service_key' \
+	'.ProjectId?|=$project_id | .PagerDuty.ServiceKey?|=$service_key' \
 	"$(dirname "$0")/inputs_3_update.template.json" >"inputs/inputs_3_update.json"
 
 #DATA_DOG
@@ -83,12 +83,12 @@ dataDogUpdateApiKey="$DATA_DOG_UPDATE_API_KEY"
 
 jq --arg project_id "$projectId" \
 	--arg data_dog_create_api_key "$dataDogCreateApiKey" \
-	'.ProjectId?|=$project_id | .ApiKey?|=$data_dog_create_api_key' \
+	'.ProjectId?|=$project_id | .Datadog.ApiKey?|=$data_dog_create_api_key' \
 	"$(dirname "$0")/inputs_4_create.template.json" >"inputs/inputs_4_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg data_dog_update_api_key "$dataDogUpdateApiKey" \
-	'.ProjectId?|=$project_id | .ApiKey?|=$data_dog_update_api_key' \
+	'.ProjectId?|=$project_id | .Datadog.ApiKey?|=$data_dog_update_api_key' \
 	"$(dirname "$0")/inputs_4_update.template.json" >"inputs/inputs_4_update.json"
 
 #OPS_GENIE
@@ -97,12 +97,12 @@ opsGenieApiKey="$OPS_GENIE_API_KEY"
 
 jq --arg project_id "$projectId" \
 	--arg ops_genie_api_key "$opsGenieApiKey" \
-	'.ProjectId?|=$project_id | .ApiKey?|=$ops_genie_api_key' \
+	'.ProjectId?|=$project_id | .OpsGenie.ApiKey?|=$ops_genie_api_key' \
 	"$(dirname "$0")/inputs_5_create.template.json" >"inputs/inputs_5_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg ops_genie_api_key "$opsGenieApiKey" \
-	'.ProjectId?|=$project_id | .ApiKey?|=$ops_genie_api_key' \
+	'.ProjectId?|=$project_id | .OpsGenie.ApiKey?|=$ops_genie_api_key' \
 	"$(dirname "$0")/inputs_5_update.template.json" >"inputs/inputs_5_update.json"
 
 #MICROSOFT_TEAMS
@@ -112,12 +112,12 @@ microsoftTeamsUpdateWebHook="$MICROSOFT_TEAMS_WEBHOOK_UPDATE_URL"
 
 jq --arg project_id "$projectId" \
 	--arg microsoft_teams_create_web_hook "$microsoftTeamsCreateWebHook" \
-	'.ProjectId?|=$project_id | .MicrosoftTeamsWebhookUrl?|=$microsoft_teams_create_web_hook' \
+	'.ProjectId?|=$project_id | .MicrosoftTeams.MicrosoftTeamsWebhookUrl?|=$microsoft_teams_create_web_hook' \
 	"$(dirname "$0")/inputs_6_create.template.json" >"inputs/inputs_6_create.json"
 
 jq --arg project_id "$projectId" \
 	--arg microsoft_teams_update_web_hook "$microsoftTeamsUpdateWebHook" \
-	'.ProjectId?|=$project_id | .MicrosoftTeamsWebhookUrl?|=$microsoft_teams_update_web_hook' \
+	'.ProjectId?|=$project_id | .MicrosoftTeams.MicrosoftTeamsWebhookUrl?|=$microsoft_teams_update_web_hook' \
 	"$(dirname "$0")/inputs_6_update.template.json" >"inputs/inputs_6_update.json"
 
 cd ..
@@ -0,0 +1,504 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
+)
+
+var objectIDRegex = regexp.MustCompile("^[0-9a-fA-F]{24}$")
+
+// TriggerRequest is the create and update request of a trigger. It extends the App Services client request,
+// which doesn't support the error handler of the trigger.
+type TriggerRequest struct {
+	*appservices.EventTriggerRequest
+	ErrorHandler *ErrorHandlerConf `json:"error_handler,omitempty"`
+}
+
+// TriggerResponse is a trigger returned by the App Services Admin API, including its error handler.
+type TriggerResponse struct {
+	ErrorHandler *ErrorHandlerConf `json:"error_handler,omitempty"`
+	appservices.EventTrigger
+}
+
+type ErrorHandlerConf struct {
+	Config *ErrorHandlerConfig `json:"config,omitempty"`
+}
+
+type ErrorHandlerConfig struct {
+	Enabled    *bool   `json:"enabled,omitempty"`
+	FunctionID *string `json:"function_id,omitempty"`
+}
+
+// EventProcess These structs are created because the client has generic map for event processor
+// and cfn generate doesn't support tags
+type EventProcess struct {
+	FUNCTION       *FUNC      `json:",omitempty"`
+	AWSEVENTBRIDGE *AWSEVENTB `json:"AWS_EVENTBRIDGE,omitempty"`
+}
+
+type FUNC struct {
+	FuncConf *FuncConf `json:"config,omitempty"`
+}
+
+type FuncConf struct {
+	FunctionID   *string `json:"function_id,omitempty"`
+	FunctionName *string `json:"function_name,omitempty"`
+}
+
+type AWSEVENTB struct {
+	AWSConfig *AWSConf `json:"config,omitempty"`
+}
+
+type AWSConf struct {
+	AccountID           *string `json:"account_id,omitempty"`
+	Region              *string `json:"region,omitempty"`
+	ExtendedJSONEnabled *bool   `json:"extended_json_enabled,omitempty"`
+}
+
+// NewTriggerRequest builds and validates the create and update request of a trigger from the model.
+func NewTriggerRequest(model *Model) (*TriggerRequest, error) {
+	et := &appservices.EventTriggerRequest{
+		Name:       aws.ToString(model.Name),
+		Type:       aws.ToString(model.Type),
+		FunctionID: aws.ToString(model.FunctionId),
+		Disabled:   model.Disabled,
+	}
+	conf, err := newEventTriggerConfig(model)
+	if err != nil {
+		return nil, err
+	}
+	et.Config = conf
+	if err := validateTriggerConfig(et); err != nil {
+		return nil, err
+	}
+
+	trigger := &TriggerRequest{EventTriggerRequest: et}
+	if model.EventProcessors != nil {
+		if err := validateEventProcessors(model.EventProcessors); err != nil {
+			return nil, err
+		}
+		if et.EventProcessors, err = newEventProcessors(model.EventProcessors); err != nil {
+			return nil, err
+		}
+		trigger.ErrorHandler = newErrorHandler(model.EventProcessors.AWSEVENTBRIDGE)
+	}
+	return trigger, nil
+}
+
+func newEventTriggerConfig(model *Model) (*appservices.EventTriggerConfig, error) {
+	conf := &appservices.EventTriggerConfig{}
+	if dTrigger := model.DatabaseTrigger; dTrigger != nil {
+		if dTrigger.Match != nil {
+			match, err := ParseExtendedJSON(*dTrigger.Match)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Match expression: %w", err)
+			}
+			conf.Match = match
+		}
+		if dTrigger.Project != nil {
+			project, err := ParseExtendedJSON(*dTrigger.Project)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Project expression: %w", err)
+			}
+			conf.Project = project
+		}
+		conf.Database = aws.ToString(dTrigger.Database)
+		conf.Collection = aws.ToString(dTrigger.Collection)
+		conf.ServiceID = aws.ToString(dTrigger.ServiceId)
+		conf.OperationTypes = dTrigger.OperationTypes
+		conf.FullDocument = dTrigger.FullDocument
+		conf.FullDocumentBeforeChange = dTrigger.FullDocumentBeforeChange
+		conf.Unordered = dTrigger.Unordered
+		conf.TolerateResumeErrors = dTrigger.TolerateResumeErrors
+		conf.SkipCatchupEvents = dTrigger.SkipCatchupEvents
+		conf.MaximumThroughput = dTrigger.MaximumThroughput
+	}
+
+	if sTrigger := model.ScheduleTrigger; sTrigger != nil {
+		conf.Schedule = aws.ToString(sTrigger.Schedule)
+		conf.SkipCatchupEvents = sTrigger.SkipcatchupEvents
+	}
+
+	if aTrigger := model.AuthTrigger; aTrigger != nil {
+		conf.OperationType = aws.ToString(aTrigger.OperationType)
+		conf.Providers = aTrigger.Providers
+	}
+	return conf, nil
+}
+
+func validateTriggerConfig(et *appservices.EventTriggerRequest) error {
+	switch et.Type {
+	case string(DATABASE):
+		if len(et.Config.OperationTypes) == 0 || et.Config.Database == "" || et.Config.Collection == "" || et.Config.ServiceID == "" {
+			return errors.New("`DatabaseTrigger` `OperationTypes`, `Database`, `Collection` and `ServiceId` must be provided if type is DATABASE")
+		}
+	case string(AUTHENTICATION):
+		if et.Config.OperationType == "" || len(et.Config.Providers) == 0 {
+			return errors.New("`AuthTrigger` `OperationType` and `Providers` must be provided if type is AUTHENTICATION")
+		}
+	case string(SCHEDULED):
+		if et.Config.Schedule == "" {
+			return errors.New("`ScheduleTrigger` `Schedule` must be provided if type is SCHEDULED")
+		}
+	}
+	return nil
+}
+
+// isEventBridgeConfigured returns false for an empty AWSEVENTBRIDGE object, which was ignored by previous versions.
+func isEventBridgeConfigured(eventBridge *AWSEVENTBRIDGE) bool {
+	if eventBridge == nil {
+		return false
+	}
+	if eventBridge.ErrorHandler != nil {
+		return true
+	}
+	return eventBridge.AWSConfig != nil && (eventBridge.AWSConfig.AccountId != nil || eventBridge.AWSConfig.Region != nil)
+}
+
+func isFunctionConfigured(function *FUNCTION) bool {
+	return function != nil && function.FuncConfig != nil &&
+		(function.FuncConfig.FunctionId != nil || function.FuncConfig.FunctionName != nil)
+}
+
+func validateEventProcessors(event *Event) error {
+	if !isEventBridgeConfigured(event.AWSEVENTBRIDGE) {
+		return nil
+	}
+	if isFunctionConfigured(event.FUNCTION) {
+		return errors.New("only one of the `FUNCTION` and `AWSEVENTBRIDGE` event processors can be configured")
+	}
+	awsConfig := event.AWSEVENTBRIDGE.AWSConfig
+	if awsConfig == nil || !util.IsStringPresent(awsConfig.AccountId) || !util.IsStringPresent(awsConfig.Region) {
+		return errors.New("`AWSConfig` `AccountId` and `Region` must be provided for the AWSEVENTBRIDGE event processor")
+	}
+	errorHandler := event.AWSEVENTBRIDGE.ErrorHandler
+	if errorHandler != nil && aws.ToBool(errorHandler.Enabled) && !util.IsStringPresent(errorHandler.FunctionId) {
+		return errors.New("`ErrorHandler` `FunctionId` must be provided when the error handler is enabled")
+	}
+	return nil
+}
+
+func newEventProcessors(event *Event) (map[string]any, error) {
+	ep := EventProcess{}
+	if isFunctionConfigured(event.FUNCTION) {
+		ep.FUNCTION = &FUNC{FuncConf: &FuncConf{
+			FunctionID:   event.FUNCTION.FuncConfig.FunctionId,
+			FunctionName: event.FUNCTION.FuncConfig.FunctionName,
+		}}
+	}
+	if isEventBridgeConfigured(event.AWSEVENTBRIDGE) {
+		awsConfig := event.AWSEVENTBRIDGE.AWSConfig
+		ep.AWSEVENTBRIDGE = &AWSEVENTB{AWSConfig: &AWSConf{
+			AccountID:           awsConfig.AccountId,
+			Region:              awsConfig.Region,
+			ExtendedJSONEnabled: awsConfig.ExtendedJsonEnabled,
+		}}
+	}
+
+	inrec, err := json.Marshal(ep)
+	if err != nil {
+		return nil, err
+	}
+	var inInterface map[string]any
+	if err := json.Unmarshal(inrec, &inInterface); err != nil {
+		return nil, err
+	}
+	if len(inInterface) == 0 {
+		return nil, nil
+	}
+	return inInterface, nil
+}
+
+func newErrorHandler(eventBridge *AWSEVENTBRIDGE) *ErrorHandlerConf {
+	if !isEventBridgeConfigured(eventBridge) || eventBridge.ErrorHandler == nil {
+		return nil
+	}
+	return &ErrorHandlerConf{Config: &ErrorHandlerConfig{
+		Enabled:    eventBridge.ErrorHandler.Enabled,
+		FunctionID: eventBridge.ErrorHandler.FunctionId,
+	}}
+}
+
+// ParseExtendedJSON parses a stringified extended JSON document, such as the Match and Project expressions of
+// a database trigger, and validates the values of its type wrappers, e.g. {"$oid": "..."} or {"$date": "..."}.
+func ParseExtendedJSON(s string) (map[string]any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("not a JSON document: %w", err)
+	}
+	if doc == nil {
+		return nil, errors.New("not a JSON document")
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+	if err := validateExtendedJSON(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func validateExtendedJSON(value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 1 {
+			for key, wrapped := range v {
+				if validate, ok := extendedJSONTypes[key]; ok {
+					if err := validate(wrapped); err != nil {
+						return fmt.Errorf("invalid %s value %v: %w", key, wrapped, err)
+					}
+					return nil
+				}
+			}
+		}
+		for _, elem := range v {
+			if err := validateExtendedJSON(elem); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, elem := range v {
+			if err := validateExtendedJSON(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// extendedJSONTypes validates the values of the extended JSON type wrappers, in canonical or relaxed mode.
+var extendedJSONTypes = map[string]func(any) error{
+	"$oid": func(v any) error {
+		if s, ok := v.(string); !ok || !objectIDRegex.MatchString(s) {
+			return errors.New("expected a 24-hexadecimal digit string")
+		}
+		return nil
+	},
+	"$date": func(v any) error {
+		switch d := v.(type) {
+		case string:
+			_, err := time.Parse(time.RFC3339Nano, d)
+			return err
+		case json.Number:
+			_, err := d.Int64()
+			return err
+		case map[string]any:
+			if n, ok := d["$numberLong"]; ok && len(d) == 1 {
+				return validateNumberString(n, 64)
+			}
+		}
+		return errors.New("expected an ISO-8601 string, milliseconds since epoch or a $numberLong document")
+	},
+	"$numberInt": func(v any) error {
+		return validateNumberString(v, 32)
+	},
+	"$numberLong": func(v any) error {
+		return validateNumberString(v, 64)
+	},
+	"$numberDouble": func(v any) error {
+		s, ok := v.(string)
+		if !ok {
+			return errors.New("expected a string")
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || (math.IsInf(f, 0) && s != "Infinity" && s != "-Infinity") {
+			return errors.New("expected a double, Infinity, -Infinity or NaN")
+		}
+		return nil
+	},
+	"$numberDecimal": func(v any) error {
+		if _, ok := v.(string); !ok {
+			return errors.New("expected a string")
+		}
+		return nil
+	},
+	"$binary": func(v any) error {
+		b, ok := v.(map[string]any)
+		if !ok {
+			return errors.New("expected a document with base64 and subType")
+		}
+		data, ok := b["base64"].(string)
+		if !ok {
+			return errors.New("expected a base64 string")
+		}
+		if _, err := base64.StdEncoding.DecodeString(data); err != nil {
+			return err
+		}
+		if _, ok := b["subType"].(string); !ok {
+			return errors.New("expected a subType string")
+		}
+		return nil
+	},
+	"$regularExpression": func(v any) error {
+		r, ok := v.(map[string]any)
+		if !ok {
+			return errors.New("expected a document with pattern and options")
+		}
+		if _, ok := r["pattern"].(string); !ok {
+			return errors.New("expected a pattern string")
+		}
+		if _, ok := r["options"].(string); !ok {
+			return errors.New("expected an options string")
+		}
+		return nil
+	},
+}
+
+func validateNumberString(v any, bitSize int) error {
+	s, ok := v.(string)
+	if !ok {
+		return errors.New("expected a string")
+	}
+	_, err := strconv.ParseInt(s, 10, bitSize)
+	return err
+}
+
+// GetTriggerModel maps a trigger returned by the App Services Admin API to the model.
+func GetTriggerModel(trigger *TriggerResponse, currentModel *Model) *Model {
+	model := new(Model)
+	if currentModel != nil {
+		model.Profile = currentModel.Profile
+		model.ProjectId = currentModel.ProjectId
+		model.AppId = currentModel.AppId
+	}
+	if trigger == nil {
+		return model
+	}
+
+	model.Id = util.StringPtr(trigger.ID)
+	model.Name = util.StringPtr(trigger.Name)
+	model.Type = util.StringPtr(trigger.Type)
+	model.Disabled = aws.Bool(aws.ToBool(trigger.Disabled))
+	model.FunctionId = util.StringPtr(trigger.FunctionID)
+	model.FunctionName = util.StringPtr(trigger.FunctionName)
+
+	conf := trigger.Config
+	switch trigger.Type {
+	case string(DATABASE):
+		var current *DatabaseConfig
+		if currentModel != nil {
+			current = currentModel.DatabaseTrigger
+		}
+		model.DatabaseTrigger = getDatabaseConfig(&conf, current)
+	case string(AUTHENTICATION):
+		model.AuthTrigger = &AuthConfig{
+			OperationType: util.StringPtr(conf.OperationType),
+			Providers:     conf.Providers,
+		}
+	case string(SCHEDULED):
+		model.ScheduleTrigger = &ScheduleConfig{
+			Schedule:          util.StringPtr(conf.Schedule),
+			SkipcatchupEvents: conf.SkipCatchupEvents,
+		}
+	}
+
+	model.EventProcessors = getEventProcessors(trigger.EventProcessors, trigger.ErrorHandler)
+	return model
+}
+
+func getDatabaseConfig(conf *appservices.EventTriggerConfig, current *DatabaseConfig) *DatabaseConfig {
+	dbConfig := &DatabaseConfig{
+		ServiceId:                util.StringPtr(conf.ServiceID),
+		Database:                 util.StringPtr(conf.Database),
+		Collection:               util.StringPtr(conf.Collection),
+		OperationTypes:           conf.OperationTypes,
+		FullDocument:             aws.Bool(aws.ToBool(conf.FullDocument)),
+		FullDocumentBeforeChange: aws.Bool(aws.ToBool(conf.FullDocumentBeforeChange)),
+		SkipCatchupEvents:        aws.Bool(aws.ToBool(conf.SkipCatchupEvents)),
+		TolerateResumeErrors:     aws.Bool(aws.ToBool(conf.TolerateResumeErrors)),
+		MaximumThroughput:        aws.Bool(aws.ToBool(conf.MaximumThroughput)),
+		Unordered:                conf.Unordered,
+	}
+	var currentMatch, currentProject *string
+	if current != nil {
+		currentMatch, currentProject = current.Match, current.Project
+	}
+	dbConfig.Match = extendedJSONString(conf.Match, currentMatch)
+	dbConfig.Project = extendedJSONString(conf.Project, currentProject)
+	return dbConfig
+}
+
+// extendedJSONString stringifies an expression returned by the API. The current value is kept when it represents
+// the same document, so that formatting differences aren't reported as drift.
+func extendedJSONString(value any, current *string) *string {
+	if value == nil {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return current
+	}
+	if current != nil {
+		got, errGot := ParseExtendedJSON(string(b))
+		want, errWant := ParseExtendedJSON(*current)
+		if errGot == nil && errWant == nil && reflect.DeepEqual(got, want) {
+			return current
+		}
+	}
+	return aws.String(string(b))
+}
+
+func getEventProcessors(eventProcessors map[string]any, errorHandler *ErrorHandlerConf) *Event {
+	if len(eventProcessors) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(eventProcessors)
+	if err != nil {
+		return nil
+	}
+	var ep EventProcess
+	if err := json.Unmarshal(b, &ep); err != nil {
+		return nil
+	}
+
+	event := new(Event)
+	if ep.FUNCTION != nil && ep.FUNCTION.FuncConf != nil {
+		event.FUNCTION = &FUNCTION{FuncConfig: &FuncConfig{
+			FunctionId:   ep.FUNCTION.FuncConf.FunctionID,
+			FunctionName: ep.FUNCTION.FuncConf.FunctionName,
+		}}
+	}
+	if ep.AWSEVENTBRIDGE != nil && ep.AWSEVENTBRIDGE.AWSConfig != nil {
+		event.AWSEVENTBRIDGE = &AWSEVENTBRIDGE{AWSConfig: &AWSConfig{
+			AccountId:           ep.AWSEVENTBRIDGE.AWSConfig.AccountID,
+			Region:              ep.AWSEVENTBRIDGE.AWSConfig.Region,
+			ExtendedJsonEnabled: aws.Bool(aws.ToBool(ep.AWSEVENTBRIDGE.AWSConfig.ExtendedJSONEnabled)),
+		}}
+		if errorHandler != nil && errorHandler.Config != nil {
+			event.AWSEVENTBRIDGE.ErrorHandler = &ErrorHandler{
+				Enabled:    aws.Bool(aws.ToBool(errorHandler.Config.Enabled)),
+				FunctionId: errorHandler.Config.FunctionID,
+			}
+		}
+	}
+	return event
+}
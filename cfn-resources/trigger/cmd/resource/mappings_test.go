@@ -0,0 +1,315 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+	"github.com/mongodb/mongodbatlas-cloudformation-resources/trigger/cmd/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExtendedJSON(t *testing.T) {
+	tests := []struct {
+		input   string
+		name    string
+		wantErr bool
+	}{
+		{
+			name:  "Match Expression",
+			input: `{"$and":[{"fullDocument.availability.value":"AVAILABLE"},{"$ne":["fullDocument.retailPrice","fullDocumentBeforeChange.retailPrice"]}]}`,
+		},
+		{
+			name:  "Canonical Types",
+			input: `{"fullDocument.ownerId":{"$oid":"5f4e3d2c1b0a998877665544"},"fullDocument.count":{"$numberLong":"42"},"fullDocument.createdAt":{"$date":{"$numberLong":"1700000000000"}}}`,
+		},
+		{
+			name:  "Relaxed Types",
+			input: `{"fullDocument.createdAt":{"$gte":{"$date":"2024-01-01T00:00:00Z"}},"fullDocument.score":{"$numberDouble":"Infinity"}}`,
+		},
+		{
+			name:    "Invalid JSON",
+			input:   `{"fullDocument.name":`,
+			wantErr: true,
+		},
+		{
+			name:    "Not A Document",
+			input:   `["fullDocument.name"]`,
+			wantErr: true,
+		},
+		{
+			name:    "Trailing Data",
+			input:   `{"fullDocument.name":"a"}{}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid ObjectId",
+			input:   `{"fullDocument._id":{"$oid":"1234"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Date",
+			input:   `{"fullDocument.createdAt":{"$date":"yesterday"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Int",
+			input:   `{"fullDocument.count":{"$numberInt":"9999999999"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resource.ParseExtendedJSON(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewTriggerRequest(t *testing.T) {
+	tests := []struct {
+		model   *resource.Model
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "Database Trigger",
+			model: &resource.Model{
+				Name: ptr.String("trigger"),
+				Type: ptr.String("DATABASE"),
+				DatabaseTrigger: &resource.DatabaseConfig{
+					ServiceId:      ptr.String("111111111111111111111111"),
+					Database:       ptr.String("store"),
+					Collection:     ptr.String("sales"),
+					OperationTypes: []string{"INSERT"},
+					Match:          ptr.String(`{"fullDocument.status":"NEW"}`),
+				},
+			},
+		},
+		{
+			name: "Database Trigger With Invalid Match",
+			model: &resource.Model{
+				Name: ptr.String("trigger"),
+				Type: ptr.String("DATABASE"),
+				DatabaseTrigger: &resource.DatabaseConfig{
+					ServiceId:      ptr.String("111111111111111111111111"),
+					Database:       ptr.String("store"),
+					Collection:     ptr.String("sales"),
+					OperationTypes: []string{"INSERT"},
+					Match:          ptr.String(`{"fullDocument.status":`),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Authentication Trigger",
+			model: &resource.Model{
+				Name:        ptr.String("trigger"),
+				Type:        ptr.String("AUTHENTICATION"),
+				AuthTrigger: &resource.AuthConfig{OperationType: ptr.String("LOGIN"), Providers: []string{"anon-user"}},
+			},
+		},
+		{
+			name: "Authentication Trigger Without Providers",
+			model: &resource.Model{
+				Name:        ptr.String("trigger"),
+				Type:        ptr.String("AUTHENTICATION"),
+				AuthTrigger: &resource.AuthConfig{OperationType: ptr.String("LOGIN")},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Scheduled Trigger Without Schedule",
+			model:   &resource.Model{Name: ptr.String("trigger"), Type: ptr.String("SCHEDULED")},
+			wantErr: true,
+		},
+		{
+			name: "EventBridge Without Region",
+			model: &resource.Model{
+				Name:            ptr.String("trigger"),
+				Type:            ptr.String("SCHEDULED"),
+				ScheduleTrigger: &resource.ScheduleConfig{Schedule: ptr.String("0 * * * *")},
+				EventProcessors: &resource.Event{AWSEVENTBRIDGE: &resource.AWSEVENTBRIDGE{
+					AWSConfig: &resource.AWSConfig{AccountId: ptr.String("123456789012")},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "EventBridge With Function",
+			model: &resource.Model{
+				Name:            ptr.String("trigger"),
+				Type:            ptr.String("SCHEDULED"),
+				ScheduleTrigger: &resource.ScheduleConfig{Schedule: ptr.String("0 * * * *")},
+				EventProcessors: &resource.Event{
+					FUNCTION: &resource.FUNCTION{FuncConfig: &resource.FuncConfig{FunctionId: ptr.String("222222222222222222222222")}},
+					AWSEVENTBRIDGE: &resource.AWSEVENTBRIDGE{
+						AWSConfig: &resource.AWSConfig{AccountId: ptr.String("123456789012"), Region: ptr.String("us-east-1")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "EventBridge Error Handler Without Function",
+			model: &resource.Model{
+				Name:            ptr.String("trigger"),
+				Type:            ptr.String("SCHEDULED"),
+				ScheduleTrigger: &resource.ScheduleConfig{Schedule: ptr.String("0 * * * *")},
+				EventProcessors: &resource.Event{AWSEVENTBRIDGE: &resource.AWSEVENTBRIDGE{
+					AWSConfig:    &resource.AWSConfig{AccountId: ptr.String("123456789012"), Region: ptr.String("us-east-1")},
+					ErrorHandler: &resource.ErrorHandler{Enabled: ptr.Bool(true)},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resource.NewTriggerRequest(tc.model)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewTriggerRequestEventBridge(t *testing.T) {
+	model := &resource.Model{
+		Name:            ptr.String("trigger"),
+		Type:            ptr.String("SCHEDULED"),
+		ScheduleTrigger: &resource.ScheduleConfig{Schedule: ptr.String("0 * * * *"), SkipcatchupEvents: ptr.Bool(true)},
+		EventProcessors: &resource.Event{
+			FUNCTION: &resource.FUNCTION{FuncConfig: &resource.FuncConfig{}},
+			AWSEVENTBRIDGE: &resource.AWSEVENTBRIDGE{
+				AWSConfig: &resource.AWSConfig{
+					AccountId:           ptr.String("123456789012"),
+					Region:              ptr.String("us-east-1"),
+					ExtendedJsonEnabled: ptr.Bool(true),
+				},
+				ErrorHandler: &resource.ErrorHandler{Enabled: ptr.Bool(true), FunctionId: ptr.String("222222222222222222222222")},
+			},
+		},
+	}
+	req, err := resource.NewTriggerRequest(model)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(req)
+	require.NoError(t, err)
+	expected := `{
+		"name": "trigger",
+		"type": "SCHEDULED",
+		"config": {"schedule": "0 * * * *", "skip_catchup_events": true},
+		"event_processors": {
+			"AWS_EVENTBRIDGE": {"config": {"account_id": "123456789012", "region": "us-east-1", "extended_json_enabled": true}}
+		},
+		"error_handler": {"config": {"enabled": true, "function_id": "222222222222222222222222"}}
+	}`
+	assert.JSONEq(t, expected, string(b))
+}
+
+func TestGetTriggerModel(t *testing.T) {
+	currentModel := &resource.Model{
+		Profile:   ptr.String("default"),
+		ProjectId: ptr.String("111111111111111111111111"),
+		AppId:     ptr.String("222222222222222222222222"),
+		DatabaseTrigger: &resource.DatabaseConfig{
+			Match: ptr.String(`{ "fullDocument.status" : "NEW" }`),
+		},
+	}
+	var trigger resource.TriggerResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"_id": "333333333333333333333333",
+		"name": "trigger",
+		"type": "DATABASE",
+		"function_id": "444444444444444444444444",
+		"function_name": "logInsert",
+		"config": {
+			"service_id": "555555555555555555555555",
+			"database": "store",
+			"collection": "sales",
+			"operation_types": ["INSERT"],
+			"match": {"fullDocument.status": "NEW"},
+			"project": {"fullDocument.status": 1},
+			"full_document": true
+		},
+		"event_processors": {
+			"FUNCTION": {"config": {"function_id": "444444444444444444444444", "function_name": "logInsert"}}
+		}
+	}`), &trigger))
+
+	expected := &resource.Model{
+		Profile:      ptr.String("default"),
+		ProjectId:    ptr.String("111111111111111111111111"),
+		AppId:        ptr.String("222222222222222222222222"),
+		Id:           ptr.String("333333333333333333333333"),
+		Name:         ptr.String("trigger"),
+		Type:         ptr.String("DATABASE"),
+		Disabled:     ptr.Bool(false),
+		FunctionId:   ptr.String("444444444444444444444444"),
+		FunctionName: ptr.String("logInsert"),
+		DatabaseTrigger: &resource.DatabaseConfig{
+			ServiceId:                ptr.String("555555555555555555555555"),
+			Database:                 ptr.String("store"),
+			Collection:               ptr.String("sales"),
+			OperationTypes:           []string{"INSERT"},
+			Match:                    ptr.String(`{ "fullDocument.status" : "NEW" }`),
+			Project:                  ptr.String(`{"fullDocument.status":1}`),
+			FullDocument:             ptr.Bool(true),
+			FullDocumentBeforeChange: ptr.Bool(false),
+			SkipCatchupEvents:        ptr.Bool(false),
+			TolerateResumeErrors:     ptr.Bool(false),
+			MaximumThroughput:        ptr.Bool(false),
+		},
+		EventProcessors: &resource.Event{
+			FUNCTION: &resource.FUNCTION{FuncConfig: &resource.FuncConfig{
+				FunctionId:   ptr.String("444444444444444444444444"),
+				FunctionName: ptr.String("logInsert"),
+			}},
+		},
+	}
+	assert.Equal(t, expected, resource.GetTriggerModel(&trigger, currentModel))
+}
+
+func TestGetTriggerModelAuthentication(t *testing.T) {
+	trigger := &resource.TriggerResponse{
+		EventTrigger: appservices.EventTrigger{
+			ID:   "333333333333333333333333",
+			Name: "trigger",
+			Type: "AUTHENTICATION",
+			Config: appservices.EventTriggerConfig{
+				OperationType: "CREATE",
+				Providers:     []string{"local-userpass", "oauth2-google"},
+			},
+		},
+	}
+	model := resource.GetTriggerModel(trigger, nil)
+	assert.Equal(t, &resource.AuthConfig{
+		OperationType: ptr.String("CREATE"),
+		Providers:     []string{"local-userpass", "oauth2-google"},
+	}, model.AuthTrigger)
+	assert.Nil(t, model.DatabaseTrigger)
+	assert.Nil(t, model.EventProcessors)
+}
@@ -66,7 +66,8 @@ type FuncConfig struct {
 
 // AWSEVENTBRIDGE is autogenerated from the json schema
 type AWSEVENTBRIDGE struct {
-	AWSConfig *AWSConfig `json:",omitempty"`
+	AWSConfig    *AWSConfig    `json:",omitempty"`
+	ErrorHandler *ErrorHandler `json:",omitempty"`
 }
 
 // AWSConfig is autogenerated from the json schema
@@ -75,3 +76,9 @@ type AWSConfig struct {
 	Region              *string `json:",omitempty"`
 	ExtendedJsonEnabled *bool   `json:",omitempty"`
 }
+
+// ErrorHandler is autogenerated from the json schema
+type ErrorHandler struct {
+	Enabled    *bool   `json:",omitempty"`
+	FunctionId *string `json:",omitempty"`
+}
@@ -16,15 +16,13 @@ package resource
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"net/http"
 
 	"github.com/aws-cloudformation/cloudformation-cli-go-plugin/cfn/handler"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
 
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/profile"
 	"github.com/mongodb/mongodbatlas-cloudformation-resources/util"
@@ -38,10 +36,12 @@ type TriggerType string
 
 const (
 	DATABASE       TriggerType = "DATABASE"
-	SCHEDULED      TriggerType = "SCHEDULE"
+	SCHEDULED      TriggerType = "SCHEDULED"
 	AUTHENTICATION TriggerType = "AUTHENTICATION"
 )
 
+const triggersPath = "triggers"
+
 var CreateRequiredFields = []string{constants.ProjectID, constants.AppID}
 var ReadRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
 var UpdateRequiredFields = []string{constants.ProjectID, constants.AppID, constants.ID}
@@ -70,15 +70,17 @@ func Create(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
-	eventTrigger, err := newEventTrigger(currentModel)
+	eventTrigger, err := NewTriggerRequest(currentModel)
 	if err != nil {
 		return progressevents.GetFailedEventByCode(fmt.Sprintf("Error creating event trigger request : %s", err.Error()),
 			string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
-	et, resp, err := client.EventTriggers.Create(ctx, *currentModel.ProjectId, *currentModel.AppId, eventTrigger)
+	et := new(TriggerResponse)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, triggersPath)
+	resp, err := util.AppServicesRequest(ctx, client, http.MethodPost, path, eventTrigger, et)
 	if err != nil {
 		_, _ = logger.Warnf("error in creating event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 	currentModel.Id = &et.ID
 
@@ -106,16 +108,17 @@ func Read(req handler.Request, prevModel *Model, currentModel *Model) (handler.P
 			string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
-	trigger, resp, err := client.EventTriggers.Get(ctx, *currentModel.ProjectId, *currentModel.AppId, *currentModel.Id)
+	trigger := new(TriggerResponse)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, triggersPath, *currentModel.Id)
+	resp, err := util.AppServicesRequest(ctx, client, http.MethodGet, path, nil, trigger)
 	if err != nil {
 		_, _ = logger.Warnf("error in getting event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
 	}
-	currentModel.Id = &trigger.ID
 
 	return handler.ProgressEvent{
 		OperationStatus: handler.Success,
-		ResourceModel:   currentModel,
+		ResourceModel:   GetTriggerModel(trigger, currentModel),
 	}, nil
 }
 
@@ -137,15 +140,16 @@ func Update(req handler.Request, prevModel *Model, currentModel *Model) (handler
 			string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
 
-	eventTrigger, err := newEventTrigger(currentModel)
+	eventTrigger, err := NewTriggerRequest(currentModel)
 	if err != nil {
 		return progressevents.GetFailedEventByCode(fmt.Sprintf("Error creating trigger request : %s", err.Error()),
 			string(types.HandlerErrorCodeInvalidRequest)), nil
 	}
-	_, resp, err := client.EventTriggers.Update(ctx, *currentModel.ProjectId, *currentModel.AppId, *currentModel.Id, eventTrigger)
+	path := util.AppServicesAppPath(*currentModel.ProjectId, *currentModel.AppId, triggersPath, *currentModel.Id)
+	resp, err := util.AppServicesRequest(ctx, client, http.MethodPut, path, eventTrigger, nil)
 	if err != nil {
 		_, _ = logger.Warnf("error in updating event trigger %v", err)
-		return progressevents.GetFailedEventByResponse(err.Error(), resp.Response), nil
+		return progressevents.GetFailedEventByResponse(err.Error(), resp), nil
 	}
 
 	return handler.ProgressEvent{
@@ -213,152 +217,3 @@ func setProfileIfAbsent(model *Model) {
 		model.Profile = aws.String(profile.DefaultProfile)
 	}
 }
-
-func newEventTrigger(model *Model) (*appservices.EventTriggerRequest, error) {
-	et := appservices.EventTriggerRequest{Disabled: model.Disabled}
-	if model.Name != nil {
-		et.Name = *model.Name
-	}
-	if model.Type != nil {
-		et.Type = *model.Type
-	}
-	if model.FunctionId != nil {
-		et.FunctionID = *model.FunctionId
-	}
-	conf := appservices.EventTriggerConfig{}
-	if model.DatabaseTrigger != nil {
-		conf.Database = *model.DatabaseTrigger.Database
-	}
-	dTrigger := model.DatabaseTrigger
-	if dTrigger != nil {
-		if dTrigger.Match != nil {
-			jsonData := []byte(*dTrigger.Match)
-			// convert the JSON string to a map
-			var m interface{}
-			if err := json.Unmarshal(jsonData, &m); err != nil {
-				return nil, errors.New("error unmarshalling Match field - " + err.Error())
-			}
-			conf.Match = m
-		}
-
-		if dTrigger.Project != nil {
-			jsonData := []byte(*dTrigger.Project)
-			// convert the JSON string to a map
-			var m interface{}
-			if err := json.Unmarshal(jsonData, &m); err != nil {
-				return nil, errors.New("error unmarshalling Project field - " + err.Error())
-			}
-			conf.Project = m
-		}
-		conf.Collection = aws.ToString(dTrigger.Collection)
-		conf.ServiceID = aws.ToString(dTrigger.ServiceId)
-		conf.OperationTypes = dTrigger.OperationTypes
-		conf.FullDocument = dTrigger.FullDocument
-		conf.FullDocumentBeforeChange = dTrigger.FullDocumentBeforeChange
-		conf.Unordered = dTrigger.Unordered
-		conf.TolerateResumeErrors = dTrigger.TolerateResumeErrors
-		conf.SkipCatchupEvents = dTrigger.SkipCatchupEvents
-		conf.MaximumThroughput = dTrigger.MaximumThroughput
-	}
-
-	if model.ScheduleTrigger != nil &&
-		model.ScheduleTrigger.Schedule != nil {
-		conf.Schedule = *model.ScheduleTrigger.Schedule
-	}
-
-	if model.AuthTrigger != nil {
-		if model.AuthTrigger.Providers != nil {
-			conf.Providers = model.AuthTrigger.Providers
-		}
-		if model.AuthTrigger.OperationType != nil {
-			conf.OperationType = *model.AuthTrigger.OperationType
-		}
-	}
-
-	et.Config = &conf
-	var err2 error
-	if model.EventProcessors != nil {
-		et, err2 = newEventProcessor(model, et)
-		if err2 != nil {
-			return &et, err2
-		}
-	}
-	switch *model.Type {
-	case string(DATABASE):
-		if len(et.Config.OperationTypes) == 0 || et.Config.Database == "" || et.Config.Collection == "" || et.Config.ServiceID == "" {
-			return &et, fmt.Errorf("`config_operation_types`, `config_database`,`config_collection`,`config_service_id` must be provided if type is DATABASE")
-		}
-	case string(AUTHENTICATION):
-		if len(et.Config.OperationTypes) == 0 || len(et.Config.Providers) == 0 {
-			return &et, fmt.Errorf("`config_operation_type`, `config_providers` must be provided if type is AUTHENTICATION")
-		}
-	case string(SCHEDULED):
-		if et.Config.Schedule == "" {
-			return &et, fmt.Errorf("`config_schedule` must be provided if type is SCHEDULED")
-		}
-	}
-	return &et, nil
-}
-
-func newEventProcessor(model *Model, et appservices.EventTriggerRequest) (appservices.EventTriggerRequest, error) {
-	ep := EventProcess{}
-	if model.EventProcessors.FUNCTION != nil && model.EventProcessors.FUNCTION.FuncConfig != nil {
-		ep.FUNCTION = new(FUNC)
-		ep.FUNCTION.FuncConf = new(FuncConf)
-		if model.EventProcessors.FUNCTION.FuncConfig.FunctionName != nil {
-			ep.FUNCTION.FuncConf.FunctionName = model.EventProcessors.FUNCTION.FuncConfig.FunctionName
-		}
-		if model.EventProcessors.FUNCTION.FuncConfig.FunctionId != nil {
-			ep.FUNCTION.FuncConf.FunctionID = model.EventProcessors.FUNCTION.FuncConfig.FunctionId
-		}
-	}
-	awsEventBridge := model.EventProcessors.AWSEVENTBRIDGE
-	if awsEventBridge != nil && awsEventBridge.AWSConfig.AccountId != nil {
-		ep.AWSEVENTBRIDGE = new(AWSEVENTB)
-		if awsEventBridge.AWSConfig != nil {
-			ep.AWSEVENTBRIDGE.AWSConfig = new(AWSConf)
-			ep.AWSEVENTBRIDGE.AWSConfig.AccountID = awsEventBridge.AWSConfig.AccountId
-			ep.AWSEVENTBRIDGE.AWSConfig.ExtendedJSONEnabled = awsEventBridge.AWSConfig.ExtendedJsonEnabled
-			ep.AWSEVENTBRIDGE.AWSConfig.Region = awsEventBridge.AWSConfig.Region
-		}
-	}
-	var inInterface map[string]interface{}
-	inrec, err := json.Marshal(ep)
-	if err != nil {
-		log.Printf("error in marshal %v", err)
-		return et, err
-	}
-	err = json.Unmarshal(inrec, &inInterface)
-	if err != nil {
-		_, _ = logger.Warnf("error %v", err)
-		return et, err
-	}
-	et.EventProcessors = inInterface
-	return et, nil
-}
-
-// EventProcess These structs are created because the client has generic map for event processor
-// and cfn generate doesn't support tags
-type EventProcess struct {
-	FUNCTION       *FUNC      `json:",omitempty"`
-	AWSEVENTBRIDGE *AWSEVENTB `json:"AWS_EVENTBRIDGE,omitempty"`
-}
-
-type FUNC struct {
-	FuncConf *FuncConf `json:"config,omitempty"`
-}
-
-type FuncConf struct {
-	FunctionID   *string `json:"function_id,omitempty"`
-	FunctionName *string `json:"function_name,omitempty"`
-}
-
-type AWSEVENTB struct {
-	AWSConfig *AWSConf `json:"config,omitempty"`
-}
-
-type AWSConf struct {
-	AccountID           *string `json:"account_id,omitempty"`
-	Region              *string `json:"region,omitempty"`
-	ExtendedJSONEnabled *bool   `json:"extended_json_enabled,omitempty"`
-}
@@ -0,0 +1,46 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mongodb-labs/go-client-mongodb-atlas-app-services/appservices"
+)
+
+const appServicesAppPath = "groups/%s/apps/%s"
+
+// AppServicesAppPath returns the App Services Admin API path of an application, optionally followed by path elements,
+// e.g. AppServicesAppPath(groupID, appID, "functions", functionID).
+func AppServicesAppPath(groupID, appID string, elem ...string) string {
+	return strings.Join(append([]string{fmt.Sprintf(appServicesAppPath, groupID, appID)}, elem...), "/")
+}
+
+// AppServicesRequest sends a request to the App Services Admin API for the endpoints and fields not covered by the
+// App Services client, such as functions, values, secrets, data sources and trigger error handlers. The response body is decoded into v when v is not nil.
+func AppServicesRequest(ctx context.Context, client *appservices.Client, method, path string, body, v any) (*http.Response, error) {
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(ctx, req, v)
+	if resp == nil {
+		return nil, err
+	}
+	return resp.Response, err
+}
@@ -39,6 +39,8 @@ const (
 	AwsAccountID            = "AwsAccountId"
 	RouteTableCIDRBlock     = "RouteTableCIDRBlock"
 	AWS                     = "AWS"
+	AZURE                   = "AZURE"
+	GCP                     = "GCP"
 	VPCID                   = "VpcId"
 	SubnetID                = "SubnetId"
 	GroupID                 = "GroupId"
@@ -59,6 +61,7 @@ const (
 	DatabaseName = "DatabaseName"
 	Username     = "Username"
 	Roles        = "Roles"
+	RoleNames    = "RoleNames"
 	AccessList   = "AccessList"
 
 	CreatingState = "CREATING"
@@ -130,6 +133,7 @@ const (
 
 	AppID                = "AppId"
 	FederationSettingsID = "FederationSettingsId"
+	Source               = "Source"
 
 	ExportBucketID             = "ExportBucketId"
 	ExportID                   = "ExportId"
@@ -140,12 +144,17 @@ const (
 	Description                = "Description"
 	AwsSecretName              = "AwsSecretName"
 	APIUserID                  = "APIUserId"
+	CloudUserID                = "UserId"
+	OrgRoles                   = "OrgRoles"
+	AlertConfigID              = "AlertConfigId"
+	AcknowledgedUntil          = "AcknowledgedUntil"
 	DataFederationRoleID       = "CloudProviderConfig.RoleId"
 	DataFederationTestS3Bucket = "CloudProviderConfig.TestS3Bucket"
 	DataProcessRegion          = "DataProcessRegion.Region"
 	SkipRoleValidation         = "SkipRoleValidation"
 	LimitName                  = "LimitName"
 	Value                      = "Value"
+	Cas                        = "Cas"
 
 	AlreadyExist = "Already Exist"
 	EmptyString  = ""
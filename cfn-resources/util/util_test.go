@@ -64,3 +64,19 @@ func TestAreStringPtrEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestAppServicesAppPath(t *testing.T) {
+	tests := []struct {
+		expected string
+		elem     []string
+	}{
+		{"groups/group/apps/app", nil},
+		{"groups/group/apps/app/secrets", []string{"secrets"}},
+		{"groups/group/apps/app/services/id/config", []string{"services", "id", "config"}},
+	}
+	for _, test := range tests {
+		if resp := util.AppServicesAppPath("group", "app", test.elem...); resp != test.expected {
+			t.Errorf("AppServicesAppPath(%v) = %v; want %v", test.elem, resp, test.expected)
+		}
+	}
+}